@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ConvMode specifies which part of a linear convolution is returned.
+type ConvMode int
+
+const (
+	// Full returns the full convolution, of length len(a)+len(b)-1.
+	Full ConvMode = iota
+	// Same returns the central part of the convolution, of length len(a).
+	Same
+	// Valid returns only the part computed without zero-padding, of length
+	// max(len(a), len(b))-min(len(a), len(b))+1.
+	Valid
+)
+
+// Convolve returns the linear convolution of a ∗ b, computed directly in the
+// time domain. mode selects the returned part of the result, like NumPy's
+// convolve (except that Same always returns len(a) samples).
+func Convolve(a, b []float64, mode ConvMode) []float64 {
+	la, lb := len(a), len(b)
+	if la == 0 || lb == 0 {
+		return []float64{}
+	}
+
+	full := make([]float64, la+lb-1)
+	for i, x := range a {
+		for j, y := range b {
+			full[i+j] += x * y
+		}
+	}
+
+	return convTrim(full, la, lb, mode)
+}
+
+// convTrim returns the part of the full convolution of sequences of lengths la
+// and lb specified by mode.
+func convTrim(full []float64, la, lb int, mode ConvMode) []float64 {
+	switch mode {
+	case Full:
+		return full
+	case Same:
+		start := (lb - 1) / 2
+		return full[start : start+la]
+	case Valid:
+		start := min(la, lb) - 1
+		return full[start : start+max(la, lb)-min(la, lb)+1]
+	}
+
+	panic("unknown convolution mode")
+}
+
+// ConvolveSeparable returns the 2-dimensional convolution of image with the
+// separable kernel Outer(colKernel, rowKernel), computed as 1-dimensional
+// convolutions of each row with rowKernel and then of each column with
+// colKernel. This costs O(len(rowKernel)+len(colKernel)) per output rather
+// than their product. mode selects the returned part of the result along each
+// axis, as in Convolve. image must be rectangular.
+func ConvolveSeparable(image [][]float64, rowKernel, colKernel []float64, mode ConvMode) [][]float64 {
+	if len(image) == 0 || len(image[0]) == 0 || len(rowKernel) == 0 || len(colKernel) == 0 {
+		return [][]float64{}
+	}
+
+	rows := make([][]float64, len(image))
+	for i, v := range image {
+		if len(v) != len(image[0]) {
+			panic("ragged array")
+		}
+		rows[i] = Convolve(v, rowKernel, mode)
+	}
+
+	var r [][]float64
+	col := make([]float64, len(rows))
+	for j := range rows[0] {
+		for i := range rows {
+			col[i] = rows[i][j]
+		}
+		c := Convolve(col, colKernel, mode)
+		if r == nil {
+			r = make([][]float64, len(c))
+			for i := range r {
+				r[i] = make([]float64, len(rows[0]))
+			}
+		}
+		for i, v := range c {
+			r[i][j] = v
+		}
+	}
+
+	return r
+}
+
+// Separable reports whether the 2-dimensional kernel has rank 1, so that it
+// equals Outer(col, row) and can be used with ConvolveSeparable. Its rank is
+// found from its singular values: the kernel is separable if the largest
+// holds all but a fraction 1e-10 of its energy. If it is separable, the
+// factors are returned. If the singular value does not converge, the kernel
+// is reported as not separable; see SeparableE.
+func Separable(kernel [][]float64) (row, col []float64, ok bool) {
+	row, col, ok, err := SeparableE(kernel)
+	if errors.Is(err, ErrLengthMismatch) {
+		panic("ragged array")
+	}
+	return row, col, ok
+}
+
+// separable_iterations is the iteration limit of SeparableE.
+var separable_iterations = 100
+
+// SeparableE is like Separable, but returns an error wrapping
+// ErrLengthMismatch instead of panicking if kernel is ragged, and one
+// wrapping ErrNotConverged if the power iteration for the largest singular
+// value reaches its limit of 100 iterations without converging.
+func SeparableE(kernel [][]float64) (row, col []float64, ok bool, err error) {
+	m := len(kernel)
+	if m == 0 || len(kernel[0]) == 0 {
+		return nil, nil, false, nil
+	}
+	n := len(kernel[0])
+
+	var energy float64
+	for i, v := range kernel {
+		if len(v) != n {
+			return nil, nil, false, fmt.Errorf("dsputils: kernel row %d has length %d, not %d: %w", i, len(v), n, ErrLengthMismatch)
+		}
+		for _, x := range v {
+			energy += x * x
+		}
+	}
+	if energy == 0 {
+		return make([]float64, n), make([]float64, m), true, nil
+	}
+
+	// a single row or column is always separable
+	if m == 1 {
+		return append([]float64{}, kernel[0]...), []float64{1}, true, nil
+	}
+	if n == 1 {
+		col = make([]float64, m)
+		for i, v := range kernel {
+			col[i] = v[0]
+		}
+		return []float64{1}, col, true, nil
+	}
+
+	// power iteration on kernelᵀkernel for the largest singular value,
+	// starting from the largest row, which is never orthogonal to the
+	// kernel's row space
+	var big []float64
+	var bigNorm float64
+	for _, v := range kernel {
+		if e := dot(v, v); e > bigNorm {
+			big, bigNorm = v, e
+		}
+	}
+	row = append([]float64{}, big...)
+	col = make([]float64, m)
+	var s float64
+	converged := false
+	for range separable_iterations {
+		for i, v := range kernel {
+			col[i] = dot(v, row)
+		}
+		for j := range row {
+			row[j] = 0
+			for i, v := range kernel {
+				row[j] += v[j] * col[i]
+			}
+		}
+		norm := math.Sqrt(dot(row, row))
+		if norm == 0 {
+			return nil, nil, false, nil
+		}
+		for j := range row {
+			row[j] /= norm
+		}
+
+		prev := s
+		s = math.Sqrt(norm)
+		if math.Abs(s-prev) <= 1e-15*s {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return nil, nil, false, fmt.Errorf("dsputils: singular value after %d iterations: %w", separable_iterations, ErrNotConverged)
+	}
+
+	// col = kernel·row = s·u, so kernel ≈ Outer(col, row)
+	for i, v := range kernel {
+		col[i] = dot(v, row)
+	}
+	if 1-s*s/energy > 1e-10 {
+		return nil, nil, false, nil
+	}
+
+	return row, col, true, nil
+}
+
+func dot(a, b []float64) float64 {
+	var r float64
+	for i, v := range a {
+		r += v * b[i]
+	}
+	return r
+}
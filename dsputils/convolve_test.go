@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"errors"
+	"testing"
+)
+
+type convolveTest struct {
+	a, b              []float64
+	full, same, valid []float64
+}
+
+var convolveTests = []convolveTest{
+	{ // odd kernel
+		[]float64{1, 2, 3, 4, 5},
+		[]float64{1, 0, -1},
+		[]float64{1, 2, 2, 2, 2, -4, -5},
+		[]float64{2, 2, 2, 2, -4},
+		[]float64{2, 2, 2},
+	},
+	{ // even kernel
+		[]float64{1, 2, 3, 4, 5},
+		[]float64{1, 1},
+		[]float64{1, 3, 5, 7, 9, 5},
+		[]float64{1, 3, 5, 7, 9},
+		[]float64{3, 5, 7, 9},
+	},
+	{ // even kernel of length 4
+		[]float64{1, 2, 3, 4, 5, 6},
+		[]float64{1, 1, 1, 1},
+		[]float64{1, 3, 6, 10, 14, 18, 15, 11, 6},
+		[]float64{3, 6, 10, 14, 18, 15},
+		[]float64{10, 14, 18},
+	},
+	{ // kernel longer than signal
+		[]float64{1, 1},
+		[]float64{1, 2, 3},
+		[]float64{1, 3, 5, 3},
+		[]float64{3, 5},
+		[]float64{3, 5},
+	},
+}
+
+func TestConvolve(t *testing.T) {
+	for _, ct := range convolveTests {
+		for _, m := range []struct {
+			mode ConvMode
+			out  []float64
+		}{
+			{Full, ct.full},
+			{Same, ct.same},
+			{Valid, ct.valid},
+		} {
+			v := Convolve(ct.a, ct.b, m.mode)
+			if !PrettyClose(v, m.out) {
+				t.Error("Convolve error\nmode:", m.mode, "\ninput:", ct.a, ct.b, "\noutput:", v, "\nexpected:", m.out)
+			}
+		}
+
+		if n := len(Convolve(ct.a, ct.b, Same)); n != len(ct.a) {
+			t.Error("Convolve same length error\noutput:", n, "\nexpected:", len(ct.a))
+		}
+	}
+}
+
+func TestConvolveSeparable(t *testing.T) {
+	image := [][]float64{
+		{1, 2, 3, 4, 5},
+		{0, 1, 0, 1, 0},
+		{5, 4, 3, 2, 1},
+		{2, 2, 2, 2, 2},
+	}
+	row := []float64{1, 2, 1}
+	col := []float64{1, -1}
+	kernel := Outer(col, row)
+
+	// direct 2-D convolution
+	e := make([][]float64, len(image)+len(kernel)-1)
+	for i := range e {
+		e[i] = make([]float64, len(image[0])+len(kernel[0])-1)
+	}
+	for i := range image {
+		for j := range image[i] {
+			for k := range kernel {
+				for l := range kernel[k] {
+					e[i+k][j+l] += image[i][j] * kernel[k][l]
+				}
+			}
+		}
+	}
+
+	v := ConvolveSeparable(image, row, col, Full)
+	if len(v) != len(e) {
+		t.Fatal("ConvolveSeparable error\noutput:", v, "\nexpected:", e)
+	}
+	for i := range e {
+		if !PrettyClose(v[i], e[i]) {
+			t.Error("ConvolveSeparable error\noutput:", v, "\nexpected:", e)
+			break
+		}
+	}
+
+	// Same is centered like Convolve and keeps the image size
+	v = ConvolveSeparable(image, row, col, Same)
+	if len(v) != len(image) || len(v[0]) != len(image[0]) {
+		t.Error("ConvolveSeparable Same size error\noutput:", len(v), len(v[0]), "\nexpected:", len(image), len(image[0]))
+	} else if !PrettyClose(v[1], e[1][1:6]) {
+		t.Error("ConvolveSeparable Same error\noutput:", v[1], "\nexpected:", e[1][1:6])
+	}
+}
+
+func TestSeparable(t *testing.T) {
+	row, col := []float64{1, 2, 1}, []float64{-1, 0, 1, 3}
+	kernel := Outer(col, row)
+
+	r, c, ok := Separable(kernel)
+	if !ok {
+		t.Fatal("Separable error\ninput:", kernel, "\noutput: not separable")
+	}
+	k := Outer(c, r)
+	for i := range kernel {
+		if !PrettyClose(k[i], kernel[i]) {
+			t.Error("Separable factors error\ninput:", kernel, "\noutput:", k)
+			break
+		}
+	}
+
+	for _, kernel := range [][][]float64{
+		{{3, -2}},
+		{{3}, {-2}},
+		{{3, -2}, {3, -2}},
+	} {
+		r, c, ok := Separable(kernel)
+		if !ok {
+			t.Error("Separable error\ninput:", kernel, "\noutput: not separable")
+			continue
+		}
+		k := Outer(c, r)
+		for i := range kernel {
+			if !PrettyClose(k[i], kernel[i]) {
+				t.Error("Separable factors error\ninput:", kernel, "\noutput:", k)
+				break
+			}
+		}
+	}
+
+	// rank 2
+	if _, _, ok := Separable([][]float64{{1, 0}, {0, 1}}); ok {
+		t.Error("Separable error\ninput:", [][]float64{{1, 0}, {0, 1}}, "\noutput: separable")
+	}
+	if _, _, ok := Separable([][]float64{{1, 2, 3}, {2, 4, 6.1}}); ok {
+		t.Error("Separable error\ninput: nearly rank 1\noutput: separable")
+	}
+}
+
+func TestSeparableE(t *testing.T) {
+	kernel := Outer([]float64{1, 2}, []float64{3, -1, 2})
+	if r, c, ok, err := SeparableE(kernel); !ok || err != nil || !PrettyClose(Outer(c, r)[1], kernel[1]) {
+		t.Error("SeparableE error\ninput:", kernel, "\noutput:", r, c, ok, err)
+	}
+
+	ragged := [][]float64{{1, 2}, {3}}
+	if _, _, ok, err := SeparableE(ragged); ok || !errors.Is(err, ErrLengthMismatch) {
+		t.Error("SeparableE error\ninput:", ragged, "\noutput:", ok, err, "\nexpected:", ErrLengthMismatch)
+	}
+
+	// two close singular values converge slowly
+	defer func(n int) { separable_iterations = n }(separable_iterations)
+	separable_iterations = 3
+	slow := [][]float64{{1, 0.1}, {0.1, 0.99}}
+	if _, _, ok, err := SeparableE(slow); ok || !errors.Is(err, ErrNotConverged) {
+		t.Error("SeparableE error\ninput:", slow, "\noutput:", ok, err, "\nexpected:", ErrNotConverged)
+	}
+	if _, _, ok := Separable(slow); ok {
+		t.Error("Separable error\ninput:", slow, "\noutput: separable")
+	}
+}
@@ -113,3 +113,67 @@ func Segment(x []complex128, segs int, noverlap float64) [][]complex128 {
 
 	return r
 }
+
+// Roll returns a copy of x circularly shifted right by shift elements:
+// element i of x is element (i+shift) mod len(x) of the result. shift may be
+// negative or larger than len(x).
+func Roll(x []complex128, shift int) []complex128 {
+	r := make([]complex128, len(x))
+	if len(x) == 0 {
+		return r
+	}
+
+	s := rollOffset(shift, len(x))
+	copy(r[s:], x)
+	copy(r, x[len(x)-s:])
+	return r
+}
+
+// RollF returns a copy of the real-valued x circularly shifted right by shift
+// elements, like Roll.
+func RollF(x []float64, shift int) []float64 {
+	r := make([]float64, len(x))
+	if len(x) == 0 {
+		return r
+	}
+
+	s := rollOffset(shift, len(x))
+	copy(r[s:], x)
+	copy(r, x[len(x)-s:])
+	return r
+}
+
+// rollOffset returns shift modulo n, in [0, n).
+func rollOffset(shift, n int) int {
+	s := shift % n
+	if s < 0 {
+		s += n
+	}
+	return s
+}
+
+// Outer returns the outer product of a and b: a len(a) x len(b) matrix whose
+// element [i][j] is a[i]*b[j]. The outer product of two 1-D windows is the
+// separable 2-D window.
+func Outer(a, b []float64) [][]float64 {
+	r := make([][]float64, len(a))
+	for i, x := range a {
+		r[i] = make([]float64, len(b))
+		for j, y := range b {
+			r[i][j] = x * y
+		}
+	}
+	return r
+}
+
+// Kron returns the Kronecker product of a and b, which is the outer product
+// flattened in row-major order: element i*len(b)+j is a[i]*b[j].
+func Kron(a, b []float64) []float64 {
+	r := make([]float64, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			r = append(r, x*y)
+		}
+	}
+	return r
+}
@@ -56,3 +56,62 @@ func TestSegment(t *testing.T) {
 		}
 	}
 }
+
+var rollTests = []struct {
+	in    []float64
+	shift int
+	out   []float64
+}{
+	{[]float64{}, 3, []float64{}},
+	{[]float64{1, 2, 3, 4, 5}, 0, []float64{1, 2, 3, 4, 5}},
+	{[]float64{1, 2, 3, 4, 5}, 2, []float64{4, 5, 1, 2, 3}},
+	{[]float64{1, 2, 3, 4, 5}, -2, []float64{3, 4, 5, 1, 2}},
+	{[]float64{1, 2, 3, 4, 5}, 5, []float64{1, 2, 3, 4, 5}},
+	{[]float64{1, 2, 3, 4, 5}, 12, []float64{4, 5, 1, 2, 3}},
+	{[]float64{1, 2, 3, 4, 5}, -13, []float64{4, 5, 1, 2, 3}},
+}
+
+func TestRoll(t *testing.T) {
+	for _, rt := range rollTests {
+		v := RollF(rt.in, rt.shift)
+		if !PrettyClose(v, rt.out) {
+			t.Error("RollF error\ninput:", rt.in, rt.shift, "\noutput:", v, "\nexpected:", rt.out)
+		}
+
+		c := Roll(ToComplex(rt.in), rt.shift)
+		if !PrettyCloseC(c, ToComplex(rt.out)) {
+			t.Error("Roll error\ninput:", rt.in, rt.shift, "\noutput:", c, "\nexpected:", rt.out)
+		}
+
+		// rolling back restores the input
+		if b := RollF(v, -rt.shift); !PrettyClose(b, rt.in) {
+			t.Error("RollF round trip error\ninput:", rt.in, rt.shift, "\noutput:", b)
+		}
+	}
+}
+
+func TestOuter(t *testing.T) {
+	h := []float64{0, 0.75, 0.75, 0} // 4-point Hann window
+	e := [][]float64{
+		{0, 0, 0, 0},
+		{0, 0.5625, 0.5625, 0},
+		{0, 0.5625, 0.5625, 0},
+		{0, 0, 0, 0},
+	}
+
+	o := Outer(h, h)
+	if len(o) != len(e) {
+		t.Fatal("Outer error\noutput:", o, "\nexpected:", e)
+	}
+	for i := range e {
+		if !PrettyClose(o[i], e[i]) {
+			t.Error("Outer error\noutput:", o, "\nexpected:", e)
+			break
+		}
+	}
+
+	k := Kron([]float64{1, 2}, []float64{1, 10, 100})
+	if ek := []float64{1, 10, 100, 2, 20, 200}; !PrettyClose(k, ek) {
+		t.Error("Kron error\noutput:", k, "\nexpected:", ek)
+	}
+}
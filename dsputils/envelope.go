@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+)
+
+// RMSEnvelope returns the root mean square of x over a sliding window of the
+// last window samples at each sample, up to and including it. The first
+// window-1 outputs average over the samples available so far. It keeps a
+// running sum of squares, so it takes O(len(x)) time for any window.
+func RMSEnvelope(x []float64, window int) []float64 {
+	if window < 1 {
+		panic("invalid window length")
+	}
+
+	r := make([]float64, len(x))
+	var sum float64
+	for i, v := range x {
+		sum += v * v
+		if i >= window {
+			sum -= x[i-window] * x[i-window]
+		}
+		r[i] = math.Sqrt(max(sum, 0) / float64(min(i+1, window)))
+	}
+
+	return r
+}
+
+// PeakEnvelope returns the envelope of x from a peak follower with separate
+// attack and release time constants, in samples (multiply times in seconds
+// by the sampling frequency). The envelope moves toward |x[i]| by a fraction
+// 1-exp(-1/attack) of the difference when |x[i]| is above it, and
+// 1-exp(-1/release) when below, so it settles within about 63% of a step
+// after one time constant. A time constant of 0 follows |x| instantly.
+func PeakEnvelope(x []float64, attack, release float64) []float64 {
+	if attack < 0 || release < 0 {
+		panic("invalid time constant")
+	}
+	a, rel := math.Exp(-1/attack), math.Exp(-1/release)
+
+	r := make([]float64, len(x))
+	var env float64
+	for i, v := range x {
+		v = math.Abs(v)
+		c := rel
+		if v > env {
+			c = a
+		}
+		env = c*env + (1-c)*v
+		r[i] = env
+	}
+
+	return r
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRMSEnvelope(t *testing.T) {
+	// 32 samples per period, and a window of 2 periods
+	x := make([]float64, 512)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * float64(i) / 32)
+	}
+	r := RMSEnvelope(x, 64)
+	for i := 63; i < len(r); i++ {
+		if math.Abs(r[i]-math.Sqrt2/2) > 1e-9 {
+			t.Error("RMSEnvelope error\nindex:", i, "\noutput:", r[i], "\nexpected:", math.Sqrt2/2)
+			break
+		}
+	}
+
+	if o, e := RMSEnvelope([]float64{3, 4, 0, 0}, 2), []float64{3, math.Sqrt(12.5), math.Sqrt(8), 0}; !PrettyClose(o, e) {
+		t.Error("RMSEnvelope error\noutput:", o, "\nexpected:", e)
+	}
+}
+
+func TestPeakEnvelope(t *testing.T) {
+	// instant attack, then an exponential release
+	x := make([]float64, 20)
+	x[0] = -2
+	r := PeakEnvelope(x, 0, 5)
+	for i, v := range r {
+		if e := 2 * math.Exp(-float64(i)/5); math.Abs(v-e) > 1e-12 {
+			t.Error("PeakEnvelope release error\nindex:", i, "\noutput:", v, "\nexpected:", e)
+			break
+		}
+	}
+
+	// a step reaches 1-1/e after one attack time constant
+	for i := range x {
+		x[i] = 1
+	}
+	r = PeakEnvelope(x, 10, 0)
+	if e := 1 - math.Exp(-1); math.Abs(r[9]-e) > 1e-12 {
+		t.Error("PeakEnvelope attack error\noutput:", r[9], "\nexpected:", e)
+	}
+}
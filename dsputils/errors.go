@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"errors"
+)
+
+// Errors returned by the error-returning (E-suffixed) variants of functions
+// in go-dsp, and by the wav package. These variants are the safe choice for
+// servers and other code that must not panic on bad input; the returned
+// errors may wrap these values, so compare them with errors.Is.
+var (
+	// ErrLengthMismatch means that inputs that must have equal lengths do not.
+	ErrLengthMismatch = errors.New("dsputils: length mismatch")
+
+	// ErrInvalidArgument means that a parameter is out of its valid range.
+	ErrInvalidArgument = errors.New("dsputils: invalid argument")
+
+	// ErrBadLength means that an input does not have a length the operation
+	// accepts, such as a spectrum of the wrong size for its signal length.
+	ErrBadLength = errors.New("dsputils: bad length")
+
+	// ErrUnsupportedFormat means that data is in a format that is not
+	// supported, such as a WAV file with an unknown audio format.
+	ErrUnsupportedFormat = errors.New("dsputils: unsupported format")
+
+	// ErrNotConverged means that an iterative method reached its iteration
+	// limit before meeting its tolerance.
+	ErrNotConverged = errors.New("dsputils: not converged")
+)
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+)
+
+// Float16ToFloat64 returns the value of the IEEE 754 half-precision
+// (binary16) floating-point number with the bits h, including subnormals,
+// infinities and NaNs.
+func Float16ToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	e := int(h>>10) & 0x1f
+	m := float64(h & 0x3ff)
+
+	switch e {
+	case 0:
+		return sign * math.Ldexp(m, -24)
+	case 0x1f:
+		if m != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(1024+m, e-25)
+}
+
+// Float64ToFloat16 returns the bits of the IEEE 754 half-precision (binary16)
+// floating-point number nearest to f, rounding ties to even. Values too
+// large in magnitude become infinities, and values too small become
+// subnormals or zeros, keeping the sign of f.
+func Float64ToFloat16(f float64) uint16 {
+	var sign uint16
+	if math.Signbit(f) {
+		sign = 0x8000
+	}
+	a := math.Abs(f)
+
+	switch {
+	case math.IsNaN(f):
+		return 0x7e00
+	case a >= 65520: // halfway between the largest half, 65504, and 2^16
+		return sign | 0x7c00
+	case a < 0x1p-14:
+		// subnormal: a multiple of 2^-24; 1024 rounds up to the smallest
+		// normal, which has the same bits
+		return sign | uint16(math.RoundToEven(a*0x1p24))
+	}
+
+	frac, exp := math.Frexp(a) // a = frac * 2^exp, frac in [0.5, 1)
+	e := exp - 1
+	m := math.RoundToEven((frac*2 - 1) * 1024)
+	if m == 1024 {
+		m = 0
+		e++
+	}
+	return sign | uint16(e+15)<<10 | uint16(m)
+}
+
+// Float16ToComplex returns the complex values of x, which holds interleaved
+// real and imaginary parts as IEEE 754 half-precision bits, as produced by
+// ComplexToFloat16. It panics if len(x) is odd.
+func Float16ToComplex(x []uint16) []complex128 {
+	if len(x)%2 != 0 {
+		panic("odd number of values")
+	}
+
+	r := make([]complex128, len(x)/2)
+	for i := range r {
+		r[i] = complex(Float16ToFloat64(x[2*i]), Float16ToFloat64(x[2*i+1]))
+	}
+	return r
+}
+
+// ComplexToFloat16 returns the real and imaginary parts of x, interleaved, as
+// IEEE 754 half-precision bits rounded with Float64ToFloat16.
+func ComplexToFloat16(x []complex128) []uint16 {
+	r := make([]uint16, 2*len(x))
+	for i, v := range x {
+		r[2*i] = Float64ToFloat16(real(v))
+		r[2*i+1] = Float64ToFloat16(imag(v))
+	}
+	return r
+}
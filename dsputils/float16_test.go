@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16(t *testing.T) {
+	for _, tt := range []struct {
+		h uint16
+		f float64
+	}{
+		{0x0000, 0},
+		{0x3c00, 1},
+		{0xc000, -2},
+		{0x3555, 0.333251953125},
+		{0x7bff, 65504},
+		{0x0400, 0x1p-14},
+		{0x03ff, 0x1.ff8p-15},
+		{0x0001, 0x1p-24},
+		{0x8001, -0x1p-24},
+		{0x7c00, math.Inf(1)},
+		{0xfc00, math.Inf(-1)},
+	} {
+		if f := Float16ToFloat64(tt.h); f != tt.f {
+			t.Errorf("Float16ToFloat64 error\ninput: %#04x\noutput: %v\nexpected: %v", tt.h, f, tt.f)
+		}
+		if h := Float64ToFloat16(tt.f); h != tt.h {
+			t.Errorf("Float64ToFloat16 error\ninput: %v\noutput: %#04x\nexpected: %#04x", tt.f, h, tt.h)
+		}
+	}
+
+	// rounding
+	for _, tt := range []struct {
+		f float64
+		h uint16
+	}{
+		{math.Copysign(0, -1), 0x8000},
+		{65519, 0x7bff},
+		{65520, 0x7c00},
+		{1e10, 0x7c00},
+		{1 + 0x1p-11, 0x3c00},   // tie, to even
+		{1 + 3*0x1p-11, 0x3c02}, // tie, to even
+		{1 + 0x1.8p-11, 0x3c01},
+		{0x1p-25, 0x0000},     // tie, to even
+		{0x1.8p-25, 0x0001},   // above the tie
+		{0x1.ffcp-15, 0x0400}, // rounds up to the smallest normal
+		{0x1.ffep-1, 0x3c00},  // rounds up to the next exponent
+		{1e-10, 0x0000},
+	} {
+		if h := Float64ToFloat16(tt.f); h != tt.h {
+			t.Errorf("Float64ToFloat16 rounding error\ninput: %v\noutput: %#04x\nexpected: %#04x", tt.f, h, tt.h)
+		}
+	}
+
+	if f := Float16ToFloat64(0x7e00); !math.IsNaN(f) {
+		t.Error("Float16ToFloat64 NaN error\noutput:", f)
+	}
+	if h := Float64ToFloat16(math.NaN()); Float16ToFloat64(h) == Float16ToFloat64(h) {
+		t.Errorf("Float64ToFloat16 NaN error\noutput: %#04x", h)
+	}
+
+	// every non-NaN value round trips
+	for h := range 1 << 16 {
+		if h&0x7c00 == 0x7c00 && h&0x3ff != 0 {
+			continue
+		}
+		if r := Float64ToFloat16(Float16ToFloat64(uint16(h))); r != uint16(h) {
+			t.Errorf("Float16 round trip error\ninput: %#04x\noutput: %#04x", h, r)
+			break
+		}
+	}
+}
+
+func TestFloat16ToComplex(t *testing.T) {
+	x := []uint16{0x3c00, 0xc000, 0x0000, 0x3555}
+	c := Float16ToComplex(x)
+	if e := []complex128{complex(1, -2), complex(0, 0.333251953125)}; !PrettyCloseC(c, e) {
+		t.Error("Float16ToComplex error\ninput:", x, "\noutput:", c, "\nexpected:", e)
+	}
+	if r := ComplexToFloat16(c); len(r) != len(x) || r[0] != x[0] || r[1] != x[1] || r[2] != x[2] || r[3] != x[3] {
+		t.Error("ComplexToFloat16 error\ninput:", c, "\noutput:", r, "\nexpected:", x)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"runtime"
+	"sync"
+)
+
+// MapFrames returns fn applied to each frame of x: the frameLen samples
+// starting at sample m*hop for frame m. Samples at the end of x that do not
+// fill a frame are not used, so there are (len(x)-frameLen)/hop+1 results, or
+// none if x is shorter than frameLen.
+// The frames are mapped concurrently on GOMAXPROCS goroutines, so fn must be
+// safe to call concurrently. Each frame is a slice of x, which fn must not
+// modify.
+func MapFrames(x []float64, frameLen, hop int, fn func([]float64) float64) []float64 {
+	return mapFrames(x, frameLen, hop, fn)
+}
+
+// MapFramesComplex is like MapFrames, for a complex-valued fn.
+func MapFramesComplex(x []float64, frameLen, hop int, fn func([]float64) complex128) []complex128 {
+	return mapFrames(x, frameLen, hop, fn)
+}
+
+func mapFrames[T any](x []float64, frameLen, hop int, fn func([]float64) T) []T {
+	if frameLen < 1 || hop < 1 {
+		panic("invalid frame length")
+	}
+	if len(x) < frameLen {
+		return []T{}
+	}
+
+	r := make([]T, (len(x)-frameLen)/hop+1)
+	num_workers := min(runtime.GOMAXPROCS(0), len(r))
+	chunk := (len(r) + num_workers - 1) / num_workers
+
+	wg := sync.WaitGroup{}
+	for start := 0; start < len(r); start += chunk {
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			for m := start; m < end; m++ {
+				i := m * hop
+				r[m] = fn(x[i : i+frameLen : i+frameLen])
+			}
+		}(start, min(start+chunk, len(r)))
+	}
+	wg.Wait()
+
+	return r
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMapFrames(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 10000)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	rms := func(f []float64) float64 {
+		var s float64
+		for _, v := range f {
+			s += v * v
+		}
+		return math.Sqrt(s / float64(len(f)))
+	}
+
+	for _, tt := range [][2]int{{256, 128}, {100, 300}, {10000, 1}, {1, 1}} {
+		frameLen, hop := tt[0], tt[1]
+		var e []float64
+		for i := 0; i+frameLen <= len(x); i += hop {
+			e = append(e, rms(x[i:i+frameLen]))
+		}
+
+		if o := MapFrames(x, frameLen, hop, rms); !PrettyClose(o, e) {
+			t.Error("MapFrames error\ninput:", tt, "\noutput:", len(o), "frames\nexpected:", len(e), "frames")
+		}
+
+		o := MapFramesComplex(x, frameLen, hop, func(f []float64) complex128 { return complex(rms(f), f[0]) })
+		for m := range e {
+			if ec := complex(e[m], x[m*hop]); o[m] != ec {
+				t.Error("MapFramesComplex error\ninput:", tt, "\nframe:", m, "\noutput:", o[m], "\nexpected:", ec)
+				break
+			}
+		}
+	}
+
+	if o := MapFrames(x[:10], 20, 5, rms); len(o) != 0 {
+		t.Error("MapFrames error\noutput:", o, "\nexpected: []")
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+// FFTFreq returns the frequency of each bin of an n-point FFT, for samples d
+// apart (the inverse of the sampling frequency), like NumPy's fft.fftfreq.
+// The result is in FFT order: zero, the positive frequencies, then the
+// negative frequencies. For even n, the Nyquist bin n/2 is given the
+// negative frequency -1/(2*d).
+func FFTFreq(n int, d float64) []float64 {
+	if n < 0 {
+		panic("invalid length")
+	}
+
+	r := make([]float64, n)
+	for i := range r {
+		k := i
+		if i >= (n+1)/2 {
+			k = i - n
+		}
+		r[i] = float64(k) / (float64(n) * d)
+	}
+
+	return r
+}
+
+// RFFTFreq returns the frequency of each of the n/2+1 bins of the FFT of a
+// real-valued n-point signal returned by fft.RFFT, for samples d apart, like
+// NumPy's fft.rfftfreq. Unlike FFTFreq, the Nyquist bin of even n is given
+// the positive frequency 1/(2*d).
+func RFFTFreq(n int, d float64) []float64 {
+	if n < 0 {
+		panic("invalid length")
+	}
+	if n == 0 {
+		return []float64{}
+	}
+
+	r := make([]float64, n/2+1)
+	for i := range r {
+		r[i] = float64(i) / (float64(n) * d)
+	}
+
+	return r
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"testing"
+)
+
+func TestFFTFreq(t *testing.T) {
+	for _, tt := range []struct {
+		n       int
+		d       float64
+		fft     []float64
+		realFFT []float64
+	}{
+		// numpy.fft.fftfreq(n, d) and numpy.fft.rfftfreq(n, d)
+		{0, 1, []float64{}, []float64{}},
+		{1, 1, []float64{0}, []float64{0}},
+		{4, 1, []float64{0, 0.25, -0.5, -0.25}, []float64{0, 0.25, 0.5}},
+		{5, 0.1, []float64{0, 2, 4, -4, -2}, []float64{0, 2, 4}},
+		{8, 0.5, []float64{0, 0.25, 0.5, 0.75, -1, -0.75, -0.5, -0.25}, []float64{0, 0.25, 0.5, 0.75, 1}},
+	} {
+		if o := FFTFreq(tt.n, tt.d); !PrettyClose(o, tt.fft) {
+			t.Error("FFTFreq error\ninput:", tt.n, tt.d, "\noutput:", o, "\nexpected:", tt.fft)
+		}
+		if o := RFFTFreq(tt.n, tt.d); !PrettyClose(o, tt.realFFT) {
+			t.Error("RFFTFreq error\ninput:", tt.n, tt.d, "\noutput:", o, "\nexpected:", tt.realFFT)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+// Levinson solves the Yule-Walker (normal) equations for the autocorrelation
+// sequence r using the Levinson-Durbin recursion. It returns the coefficients
+// of the order-(len(r)-1) prediction error filter, with a[0] = 1, and the
+// prediction error variance.
+// Reference: http://www.mathworks.com/help/signal/ref/levinson.html
+func Levinson(r []float64) ([]float64, float64) {
+	if len(r) == 0 {
+		panic("empty autocorrelation")
+	}
+
+	a := make([]float64, len(r))
+	t := make([]float64, len(r))
+	a[0] = 1
+	e := r[0]
+
+	for m := 1; m < len(r); m++ {
+		acc := r[m]
+		for i := 1; i < m; i++ {
+			acc += a[i] * r[m-i]
+		}
+		k := -acc / e
+
+		copy(t, a[:m])
+		for i := 1; i < m; i++ {
+			a[i] += k * t[m-i]
+		}
+		a[m] = k
+		e *= 1 - k*k
+	}
+
+	return a, e
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"testing"
+)
+
+var levinsonTests = [][]float64{
+	{1},
+	{1, 0.5},
+	{3, 1, -0.5},
+	{4, 2.5, 1.2, 0.3, -0.4},
+}
+
+func TestLevinson(t *testing.T) {
+	for _, r := range levinsonTests {
+		a, e := Levinson(r)
+		if len(a) != len(r) || a[0] != 1 {
+			t.Error("Levinson error\ninput:", r, "\noutput:", a)
+			continue
+		}
+
+		// a must satisfy the normal equations: row 0 gives the error, and
+		// all other rows are zero.
+		for i := range r {
+			var v float64
+			for j, c := range a {
+				v += c * r[int(math.Abs(float64(i-j)))]
+			}
+
+			want := 0.0
+			if i == 0 {
+				want = e
+			}
+			if !Float64Equal(v, want) {
+				t.Error("Levinson error\ninput:", r, "\nrow:", i, "\noutput:", v, "\nexpected:", want)
+			}
+		}
+	}
+
+	a, e := Levinson([]float64{1, 0.5})
+	if !PrettyClose(a, []float64{1, -0.5}) || !Float64Equal(e, 0.75) {
+		t.Error("Levinson error\noutput:", a, e, "\nexpected:", []float64{1, -0.5}, 0.75)
+	}
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+)
+
+// HzToMIDI returns the MIDI note number of the frequency f in Hz, with A4 at
+// 440 Hz as note 69 and 12 notes per octave. The result is fractional for
+// frequencies between equal-tempered notes.
+func HzToMIDI(f float64) float64 {
+	return 69 + 12*math.Log2(f/440)
+}
+
+// MIDIToHz returns the frequency in Hz of the MIDI note number note, which may
+// be fractional. It is the inverse of HzToMIDI.
+func MIDIToHz(note float64) float64 {
+	return 440 * math.Exp2((note-69)/12)
+}
+
+// CentsBetween returns the interval from f1 to f2 in cents, hundredths of an
+// equal-tempered semitone: positive if f2 is higher, and 1200 per octave.
+func CentsBetween(f1, f2 float64) float64 {
+	return 1200 * math.Log2(f2/f1)
+}
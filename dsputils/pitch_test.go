@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"testing"
+)
+
+func TestPitch(t *testing.T) {
+	for _, tt := range []struct {
+		hz, midi float64
+	}{
+		{440, 69},
+		{880, 81},
+		{261.6255653005986, 60},
+		{8.175798915643707, 0},
+		{452.8929841231365, 69.5},
+	} {
+		if o := HzToMIDI(tt.hz); !Float64Equal(o, tt.midi) {
+			t.Error("HzToMIDI error\ninput:", tt.hz, "\noutput:", o, "\nexpected:", tt.midi)
+		}
+		if o := MIDIToHz(tt.midi); !Float64Equal(o, tt.hz) {
+			t.Error("MIDIToHz error\ninput:", tt.midi, "\noutput:", o, "\nexpected:", tt.hz)
+		}
+	}
+
+	for _, tt := range []struct {
+		f1, f2, cents float64
+	}{
+		{440, 880, 1200},
+		{880, 440, -1200},
+		{100, 100, 0},
+		{440, MIDIToHz(70), 100},
+	} {
+		if o := CentsBetween(tt.f1, tt.f2); !Float64Equal(o, tt.cents) {
+			t.Error("CentsBetween error\ninput:", tt.f1, tt.f2, "\noutput:", o, "\nexpected:", tt.cents)
+		}
+	}
+}
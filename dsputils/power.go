@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+// PowerSpectrum returns the squared magnitude, real^2+imag^2, of each element
+// of the spectrum x.
+func PowerSpectrum(x []complex128) []float64 {
+	r := make([]float64, len(x))
+	PowerSpectrumInto(r, x)
+	return r
+}
+
+// PowerSpectrumInto stores the squared magnitude of each element of x in dst,
+// which must have the same length.
+func PowerSpectrumInto(dst []float64, x []complex128) {
+	if len(dst) != len(x) {
+		panic("arrays not of equal size")
+	}
+
+	for i, v := range x {
+		re, im := real(v), imag(v)
+		dst[i] = re*re + im*im
+	}
+}
+
+// PowerSpectrumOneSided returns the one-sided power spectrum of a real-valued
+// signal of length n from x, the n/2+1 non-negative frequency bins of its FFT
+// as returned by fft.RFFT. Each bin except DC and, for even n, Nyquist is
+// doubled to account for its negative frequency counterpart, so the result
+// sums to the same total as the two-sided PowerSpectrum of the full FFT:
+// n times the energy of the signal.
+func PowerSpectrumOneSided(x []complex128, n int) []float64 {
+	if len(x) != n/2+1 {
+		panic("invalid spectrum length")
+	}
+
+	r := PowerSpectrum(x)
+	for k := 1; k < len(r); k++ {
+		if n%2 == 1 || k < n/2 {
+			r[k] *= 2
+		}
+	}
+	return r
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestPowerSpectrum(t *testing.T) {
+	x := []complex128{3 + 4i, -1, 2i, 0}
+	e := []float64{25, 1, 4, 0}
+	if o := PowerSpectrum(x); !PrettyClose(o, e) {
+		t.Error("PowerSpectrum error\ninput:", x, "\noutput:", o, "\nexpected:", e)
+	}
+
+	dst := make([]float64, len(x))
+	PowerSpectrumInto(dst, x)
+	if !PrettyClose(dst, e) {
+		t.Error("PowerSpectrumInto error\ninput:", x, "\noutput:", dst, "\nexpected:", e)
+	}
+}
+
+func TestPowerSpectrumOneSided(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 7, 8, 63, 64} {
+		x := make([]float64, n)
+		var energy float64
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+			energy += x[i] * x[i]
+		}
+
+		// the non-negative frequency bins of the DFT
+		f := make([]complex128, n/2+1)
+		for k := range f {
+			for j, v := range x {
+				f[k] += complex(v, 0) * cmplx.Rect(1, -2*math.Pi*float64(k*j)/float64(n))
+			}
+		}
+
+		var sum float64
+		for _, p := range PowerSpectrumOneSided(f, n) {
+			sum += p
+		}
+		if !Float64Equal(sum/float64(n), energy) {
+			t.Error("PowerSpectrumOneSided error\nlength:", n, "\noutput:", sum/float64(n), "\nexpected:", energy)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"fmt"
+	"math"
+)
+
+// Resampler resamples a stream of blocks by the rational factor up/down with
+// a polyphase FIR anti-aliasing filter. Filter state is kept between calls to
+// Process, so the stream is resampled as if it were one signal.
+type Resampler struct {
+	up, down int
+	h        []float64
+
+	buf []float64 // input samples needed by the next output
+	off int       // stream index of buf[0]
+	t   int       // upsampled stream index of the next output
+}
+
+// Quality selects the trade-off between the accuracy and cost of the
+// anti-aliasing filter of a Resampler.
+type Quality int
+
+const (
+	// Low uses a short filter (4 zero crossings on each side, Kaiser beta 3),
+	// with a wide transition band and about 35 dB of stopband attenuation.
+	// It is fast and has little delay.
+	Low Quality = iota
+	// Medium uses 10 zero crossings on each side and Kaiser beta 5, for about
+	// 55 dB of stopband attenuation. It is the quality of NewResampler and
+	// Resample.
+	Medium
+	// High uses a long filter (32 zero crossings on each side, Kaiser beta 9),
+	// with a narrow transition band and about 90 dB of stopband attenuation.
+	High
+)
+
+// filterParams returns the number of zero crossings on each side and the
+// Kaiser window beta of the filter for q.
+func (q Quality) filterParams() (int, float64) {
+	switch q {
+	case Low:
+		return 4, 3
+	case Medium:
+		return 10, 5
+	case High:
+		return 32, 9
+	}
+	panic("unknown quality")
+}
+
+// NewResampler returns a Resampler that changes the sample rate by up/down,
+// with Medium quality. The filter is a Kaiser-windowed sinc with its cutoff
+// at the lower of the two Nyquist frequencies, similar to SciPy's
+// resample_poly.
+func NewResampler(up, down int) *Resampler {
+	return NewResamplerQuality(up, down, Medium)
+}
+
+// NewResamplerQuality returns a Resampler that changes the sample rate by
+// up/down, with the filter quality q.
+func NewResamplerQuality(up, down int, q Quality) *Resampler {
+	if up < 1 || down < 1 {
+		panic("invalid resampling factors")
+	}
+	zeros, beta := q.filterParams()
+
+	g := gcd(up, down)
+	up, down = up/g, down/g
+
+	// Make the filter's delay a multiple of down, so the output delay is a
+	// whole number of samples.
+	l := max(up, down)
+	d := down * ((zeros*l + down - 1) / down)
+
+	h := make([]float64, 2*d+1)
+	w := Kaiser(len(h), beta)
+	for i := range h {
+		h[i] = float64(up) / float64(l) * sinc(float64(i-d)/float64(l)) * w[i]
+	}
+
+	return &Resampler{up: up, down: down, h: h}
+}
+
+// Delay returns the number of output samples by which the output of r lags
+// its input.
+func (r *Resampler) Delay() int {
+	return (len(r.h) - 1) / 2 / r.down
+}
+
+// Process returns the resampled output available after the block in. The
+// total output of successive calls is independent of how the stream is split
+// into blocks.
+func (r *Resampler) Process(in []float64) []float64 {
+	r.buf = append(r.buf, in...)
+	end := r.off + len(r.buf)
+	lh := len(r.h)
+
+	var out []float64
+	for ; r.t/r.up < end; r.t += r.down {
+		// inputs j with 0 <= t-j*up < len(h)
+		var s float64
+		for j := max(ceilDiv(r.t-lh+1, r.up), r.off); j <= r.t/r.up; j++ {
+			s += r.buf[j-r.off] * r.h[r.t-j*r.up]
+		}
+		out = append(out, s)
+	}
+
+	// drop inputs that no later output needs
+	if keep := ceilDiv(r.t-lh+1, r.up); keep > r.off {
+		n := min(keep-r.off, len(r.buf))
+		r.buf = append(r.buf[:0], r.buf[n:]...)
+		r.off += n
+	}
+
+	return out
+}
+
+// Resample returns x resampled by the rational factor up/down, using the
+// filter of NewResampler. The filter delay is compensated, and the result has
+// ceil(len(x)*up/down) samples.
+// It panics if up or down is less than 1; see ResampleE.
+func Resample(x []float64, up, down int) []float64 {
+	return ResampleQuality(x, up, down, Medium)
+}
+
+// ResampleQuality is like Resample, but uses the filter quality q.
+func ResampleQuality(x []float64, up, down int, q Quality) []float64 {
+	r := NewResamplerQuality(up, down, q)
+	n := (len(x)*r.up + r.down - 1) / r.down
+	delay := r.Delay()
+
+	// pad with enough zeros to compute the last delayed output
+	last := ((delay+n-1)*r.down)/r.up + 1
+	y := r.Process(ZeroPadF(x, last))
+
+	out := make([]float64, n)
+	copy(out, y[delay:])
+	return out
+}
+
+// ResampleE is like Resample, but returns an error wrapping
+// ErrInvalidArgument instead of panicking if up or down is less than 1.
+func ResampleE(x []float64, up, down int) ([]float64, error) {
+	if up < 1 || down < 1 {
+		return nil, fmt.Errorf("dsputils: resampling factors %d/%d: %w", up, down, ErrInvalidArgument)
+	}
+
+	return Resample(x, up, down), nil
+}
+
+// maxRateDenominator caps the factors of the rational approximation in
+// ResampleRate, which bounds the length of the polyphase filter.
+const maxRateDenominator = 1000
+
+// ResampleRate returns x resampled by ratio, the output sampling rate over
+// the input rate, like Resample with up/down the best rational approximation
+// of ratio from its continued fraction whose factors are at most 1000. For
+// example, 44100/48000 is exactly 147/160, while an irrational ratio is
+// approximated to within about 1e-6 of its value. The result has
+// ceil(len(x)*up/down) samples.
+// It panics if ratio is not positive, or too far from 1 to approximate with
+// factors of at most 1000.
+func ResampleRate(x []float64, ratio float64) []float64 {
+	if !(ratio > 0) || math.IsInf(ratio, 1) {
+		panic("invalid resampling ratio")
+	}
+	up, down := ratApprox(ratio, maxRateDenominator)
+	if up == 0 {
+		panic("invalid resampling ratio")
+	}
+
+	return Resample(x, up, down)
+}
+
+// ratApprox returns the last convergent p/q of the continued fraction of r
+// with both p and q at most max, or 0/1 if there is none.
+func ratApprox(r float64, max int) (p, q int) {
+	p0, q0, p1, q1 := 0, 1, 1, 0
+	for f := r; ; {
+		a := math.Floor(f)
+		if a > float64(max) {
+			break
+		}
+		p2, q2 := int(a)*p1+p0, int(a)*q1+q0
+		if p2 > max || q2 > max {
+			break
+		}
+		p0, q0, p1, q1 = p1, q1, p2, q2
+
+		frac := f - a
+		if frac < 1e-12 || math.Abs(float64(p1)/float64(q1)-r) < 1e-12*r {
+			break
+		}
+		f = 1 / frac
+	}
+	if q1 == 0 {
+		return 0, 1
+	}
+	return p1, q1
+}
+
+// ceilDiv returns ceil(a/b) for b > 0.
+func ceilDiv(a, b int) int {
+	if a >= 0 {
+		return (a + b - 1) / b
+	}
+	return -(-a / b)
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// Kaiser returns an n-point symmetric Kaiser window with shape parameter
+// beta. Larger beta lowers the sidelobes and widens the main lobe; beta 0 is
+// the rectangular window. It is also available as window.Kaiser.
+// Reference: http://www.mathworks.com/help/signal/ref/kaiser.html
+func Kaiser(n int, beta float64) []float64 {
+	r := make([]float64, n)
+	if n == 1 {
+		r[0] = 1
+		return r
+	}
+
+	d := besselI0(beta)
+	for i := range r {
+		x := 2*float64(i)/float64(n-1) - 1
+		r[i] = besselI0(beta*math.Sqrt(1-x*x)) / d
+	}
+	return r
+}
+
+// besselI0 returns the zeroth-order modified Bessel function of the first
+// kind, computed from its power series.
+func besselI0(x float64) float64 {
+	s, t := 1.0, 1.0
+	for k := 1; t > 1e-17*s; k++ {
+		f := x / (2 * float64(k))
+		t *= f * f
+		s += t
+	}
+	return s
+}
@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestResampler(t *testing.T) {
+	x := make([]float64, 1000)
+	for i := range x {
+		x[i] = math.Sin(float64(i)*0.05) + 0.5*math.Cos(float64(i)*0.31)
+	}
+
+	for _, f := range [][2]int{{1, 1}, {3, 2}, {2, 3}, {160, 147}, {1, 4}} {
+		whole := NewResampler(f[0], f[1]).Process(x)
+
+		// feed the same signal in irregular blocks
+		r := NewResampler(f[0], f[1])
+		var chunked []float64
+		for i, n := 0, 1; i < len(x); i, n = i+n, n%37+1 {
+			chunked = append(chunked, r.Process(x[i:min(i+n, len(x))])...)
+		}
+
+		if !PrettyClose(chunked, whole) {
+			t.Error("Resampler block error\nfactors:", f, "\nlength:", len(chunked), "\nexpected:", len(whole))
+		}
+	}
+}
+
+func TestResample(t *testing.T) {
+	const w = 0.05 // radians per input sample
+
+	x := make([]float64, 2000)
+	for i := range x {
+		x[i] = math.Sin(w * float64(i))
+	}
+
+	for _, f := range [][2]int{{3, 2}, {2, 3}, {160, 147}} {
+		y := Resample(x, f[0], f[1])
+		if n := (len(x)*f[0] + f[1] - 1) / f[1]; len(y) != n {
+			t.Error("Resample length error\nfactors:", f, "\noutput:", len(y), "\nexpected:", n)
+			continue
+		}
+
+		// away from the edges, y is the same sinusoid at the new rate
+		ratio := float64(f[1]) / float64(f[0])
+		for i := len(y) / 4; i < 3*len(y)/4; i++ {
+			if e := math.Sin(w * float64(i) * ratio); math.Abs(y[i]-e) > 1e-2 {
+				t.Error("Resample error\nfactors:", f, "\nindex:", i, "\noutput:", y[i], "\nexpected:", e)
+				break
+			}
+		}
+	}
+}
+
+func TestResampleE(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	for _, f := range [][2]int{{0, 1}, {1, 0}, {-2, 3}} {
+		if y, err := ResampleE(x, f[0], f[1]); !errors.Is(err, ErrInvalidArgument) || y != nil {
+			t.Error("ResampleE error\nfactors:", f, "\noutput:", y, err, "\nexpected:", ErrInvalidArgument)
+		}
+	}
+
+	y, err := ResampleE(x, 3, 2)
+	if e := Resample(x, 3, 2); err != nil || !PrettyClose(y, e) {
+		t.Error("ResampleE error\nfactors:", [2]int{3, 2}, "\noutput:", y, err, "\nexpected:", e)
+	}
+}
+
+func TestResampleRate(t *testing.T) {
+	for _, tt := range []struct {
+		ratio    float64
+		up, down int
+	}{
+		{44100.0 / 48000, 147, 160},
+		{0.918367, 45, 49},
+		{2, 2, 1},
+		{0.5, 1, 2},
+		{math.Pi, 355, 113},
+	} {
+		if up, down := ratApprox(tt.ratio, maxRateDenominator); up != tt.up || down != tt.down {
+			t.Error("ratApprox error\ninput:", tt.ratio, "\noutput:", up, down, "\nexpected:", tt.up, tt.down)
+		}
+	}
+
+	// 48 kHz to 44.1 kHz: the output has the expected length, and a 1 kHz
+	// tone passes unchanged
+	const fs = 48000
+	x := make([]float64, fs/2)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / fs)
+	}
+	y := ResampleRate(x, 0.918367)
+	if e := float64(len(x)) * 0.918367; math.Abs(float64(len(y))-e) > 1 {
+		t.Error("ResampleRate length error\noutput:", len(y), "\nexpected:", e)
+	}
+	for i := len(y) / 4; i < 3*len(y)/4; i++ {
+		if e := math.Sin(2 * math.Pi * 1000 * float64(i) * 49 / 45 / fs); math.Abs(y[i]-e) > 1e-2 {
+			t.Error("ResampleRate error\nindex:", i, "\noutput:", y[i], "\nexpected:", e)
+			break
+		}
+	}
+
+	// a tone above the output Nyquist frequency is removed instead of
+	// aliasing, as in TestResampleQuality
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * 0.19 * float64(i))
+	}
+	y = ResampleRate(x, 1/3.0)
+	var s float64
+	mid := y[len(y)/4 : 3*len(y)/4]
+	for _, v := range mid {
+		s += v * v
+	}
+	if rms := math.Sqrt(s / float64(len(mid))); rms > 1e-2 {
+		t.Error("ResampleRate aliasing error\noutput:", rms, "\nexpected: < 0.01")
+	}
+
+	for _, r := range []float64{0, -1, math.NaN(), math.Inf(1), 1e-6} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("ResampleRate error\ninput:", r, "\nexpected panic")
+				}
+			}()
+			ResampleRate([]float64{1, 2, 3}, r)
+		}()
+	}
+}
+
+func TestResampleQuality(t *testing.T) {
+	// a tone above the output Nyquist frequency (1/6) of downsampling by 3,
+	// which aliases to 1/3-0.19 if it is not filtered out
+	x := make([]float64, 6000)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * 0.19 * float64(i))
+	}
+
+	alias := make(map[Quality]float64)
+	for _, q := range []Quality{Low, Medium, High} {
+		y := ResampleQuality(x, 1, 3, q)
+
+		// RMS away from the edges
+		var s float64
+		mid := y[len(y)/4 : 3*len(y)/4]
+		for _, v := range mid {
+			s += v * v
+		}
+		alias[q] = math.Sqrt(s / float64(len(mid)))
+	}
+
+	if !(alias[High] < alias[Medium] && alias[Medium] < alias[Low]) || alias[High] > 1e-3 {
+		t.Error("ResampleQuality aliasing error\noutput:", alias, "\nexpected: High < Medium < Low")
+	}
+
+	if y, e := ResampleQuality(x, 3, 2, Medium), Resample(x, 3, 2); !PrettyClose(y, e) {
+		t.Error("ResampleQuality Medium error")
+	}
+}
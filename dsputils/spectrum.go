@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+// ResizeSpectrum returns the FFT x, in FFT order, resized to newLen bins by
+// inserting or removing high frequency bins around the Nyquist frequency,
+// which is the basis of FFT resampling (see fft.ResampleFFT). The low
+// frequencies keep their bins, positive at the start and negative at the end.
+// When the shorter length is even, its Nyquist bin is split evenly between
+// the positive and negative frequencies on growing, and the two bins that
+// fold onto it are summed on shrinking, so conjugate symmetry is preserved
+// and shrinking undoes growing.
+// The bins are not scaled: the inverse FFT of the result, multiplied by
+// newLen/len(x), is the signal resampled to newLen samples.
+func ResizeSpectrum(x []complex128, newLen int) []complex128 {
+	if newLen < 0 {
+		panic("invalid length")
+	}
+	n := len(x)
+	r := make([]complex128, newLen)
+	if n == 0 || newLen == 0 {
+		return r
+	}
+
+	m := min(n, newLen)
+	copy(r[:(m+1)/2], x)
+	for k := 1; k <= (m-1)/2; k++ {
+		r[newLen-k] = x[n-k]
+	}
+
+	if k := m / 2; m%2 == 0 {
+		switch {
+		case newLen > n:
+			r[k] = x[k] / 2
+			r[newLen-k] = x[k] / 2
+		case newLen < n:
+			r[k] = x[k] + x[n-k]
+		default:
+			r[k] = x[k]
+		}
+	}
+
+	return r
+}
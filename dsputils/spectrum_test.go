@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+// dft returns the discrete Fourier transform of x, computed directly, with
+// sign -1 for the forward and +1 for the unscaled inverse transform.
+func dft(x []complex128, sign float64) []complex128 {
+	n := len(x)
+	r := make([]complex128, n)
+	for k := range r {
+		for i, v := range x {
+			r[k] += v * cmplx.Exp(complex(0, sign*2*math.Pi*float64(k*i)/float64(n)))
+		}
+	}
+	return r
+}
+
+func TestResizeSpectrum(t *testing.T) {
+	// a real periodic signal bandlimited below the Nyquist frequency of both
+	// lengths
+	signal := func(t float64) float64 {
+		return 1 + math.Cos(2*math.Pi*t) + 0.5*math.Sin(2*math.Pi*3*t+0.2)
+	}
+	for _, tt := range [][2]int{{16, 40}, {15, 32}, {16, 9}} {
+		n, num := tt[0], tt[1]
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(signal(float64(i)/float64(n)), 0)
+		}
+		X := dft(x, -1)
+
+		Y := ResizeSpectrum(X, num)
+		y := dft(Y, 1)
+		for i, v := range y {
+			e := signal(float64(i) / float64(num))
+			if o := v / complex(float64(n), 0); cmplx.Abs(o-complex(e, 0)) > 1e-9 {
+				t.Error("ResizeSpectrum resample error\ninput:", tt, "\nindex:", i, "\noutput:", o, "\nexpected:", e)
+				break
+			}
+		}
+
+		var ex, ey float64
+		for i := range X {
+			ex += real(X[i] * cmplx.Conj(X[i]))
+		}
+		for i := range Y {
+			ey += real(Y[i] * cmplx.Conj(Y[i]))
+		}
+		if math.Abs(ex-ey) > 1e-9*ex {
+			t.Error("ResizeSpectrum energy error\ninput:", tt, "\noutput:", ey, "\nexpected:", ex)
+		}
+	}
+
+	// shrinking undoes growing, including the Nyquist bin
+	rnd := rand.New(rand.NewSource(1))
+	for _, tt := range [][2]int{{8, 13}, {8, 16}, {7, 12}, {1, 4}} {
+		x := make([]complex128, tt[0])
+		for i := range x {
+			x[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		if o := ResizeSpectrum(ResizeSpectrum(x, tt[1]), tt[0]); !PrettyCloseC(o, x) {
+			t.Error("ResizeSpectrum round trip error\ninput:", tt, "\noutput:", o, "\nexpected:", x)
+		}
+	}
+
+	if o := ResizeSpectrum(nil, 3); !PrettyCloseC(o, []complex128{0, 0, 0}) {
+		t.Error("ResizeSpectrum error\noutput:", o, "\nexpected: [0 0 0]")
+	}
+}
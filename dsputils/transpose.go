@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+// transposeBlock is the side length of the tiles copied by Transpose. A
+// 32x32 tile of complex128 values is 16 KiB, which fits in the L1 cache.
+const transposeBlock = 32
+
+// Transpose returns the transpose of data, a rows x cols matrix stored in
+// row-major order. The result is a cols x rows matrix in row-major order.
+// It copies the matrix in square tiles, so reads and writes both stay within
+// a few cache lines for large matrices.
+func Transpose(data []complex128, rows, cols int) []complex128 {
+	if len(data) != rows*cols {
+		panic("data length does not match dimensions")
+	}
+
+	r := make([]complex128, len(data))
+	for i0 := 0; i0 < rows; i0 += transposeBlock {
+		i1 := min(i0+transposeBlock, rows)
+		for j0 := 0; j0 < cols; j0 += transposeBlock {
+			j1 := min(j0+transposeBlock, cols)
+			for i := i0; i < i1; i++ {
+				for j := j0; j < j1; j++ {
+					r[j*rows+i] = data[i*cols+j]
+				}
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package dsputils
+
+import (
+	"testing"
+)
+
+// naiveTranspose is the unblocked transpose that Transpose is compared with.
+func naiveTranspose(data []complex128, rows, cols int) []complex128 {
+	r := make([]complex128, len(data))
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			r[j*rows+i] = data[i*cols+j]
+		}
+	}
+	return r
+}
+
+func TestTranspose(t *testing.T) {
+	for _, d := range [][2]int{{0, 0}, {1, 1}, {1, 5}, {5, 1}, {3, 4}, {31, 33}, {64, 100}} {
+		rows, cols := d[0], d[1]
+		x := make([]complex128, rows*cols)
+		for i := range x {
+			x[i] = complex(float64(i), -float64(i))
+		}
+
+		v := Transpose(x, rows, cols)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				if v[j*rows+i] != x[i*cols+j] {
+					t.Error("Transpose error\ndims:", d, "\nindex:", i, j, "\noutput:", v[j*rows+i], "\nexpected:", x[i*cols+j])
+				}
+			}
+		}
+
+		// transposing twice is the identity
+		if !PrettyCloseC(Transpose(v, cols, rows), x) {
+			t.Error("Transpose round trip error\ndims:", d)
+		}
+	}
+}
+
+func BenchmarkTranspose(b *testing.B) {
+	const n = 2048
+	x := make([]complex128, n*n)
+
+	b.Run("Blocked", func(b *testing.B) {
+		for b.Loop() {
+			Transpose(x, n, n)
+		}
+	})
+	b.Run("Naive", func(b *testing.B) {
+		for b.Loop() {
+			naiveTranspose(x, n, n)
+		}
+	})
+}
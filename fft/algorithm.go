@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// Algorithm is an FFT algorithm of the built-in engine.
+type Algorithm int
+
+const (
+	// Auto uses Radix2 for power of 2 lengths and Bluestein otherwise.
+	Auto Algorithm = iota
+	// Radix2 is the iterative, parallel radix-2 Cooley-Tukey algorithm, for
+	// power of 2 lengths.
+	Radix2
+	// SplitRadix is the recursive split-radix algorithm, which needs fewer
+	// multiplications than Radix2, for power of 2 lengths.
+	SplitRadix
+	// MixedRadix is the recursive Cooley-Tukey algorithm over the prime
+	// factors of the length, for any length. Its cost is proportional to
+	// the sum of the factors, so it is O(n^2) for prime lengths.
+	MixedRadix
+	// Bluestein is the chirp-z algorithm, which computes a transform of any
+	// length with power of 2 FFTs of at least twice the length.
+	Bluestein
+)
+
+var (
+	fft_algorithm = Auto
+)
+
+// SetAlgorithm sets the algorithm used by the built-in engine for FFT and
+// IFFT, overriding the automatic choice, to compare their accuracy and
+// speed. The default is Auto.
+// While Radix2 or SplitRadix is set, FFT and IFFT panic on lengths that are
+// not powers of 2, before doing any work; FFTE and IFFTE return an error
+// instead. See Supports.
+func SetAlgorithm(algo Algorithm) {
+	if algo < Auto || algo > Bluestein {
+		panic("unknown algorithm")
+	}
+
+	radix2Lock.Lock()
+	fft_algorithm = algo
+	radix2Lock.Unlock()
+}
+
+// Supports reports whether a can compute transforms of length n.
+func (a Algorithm) Supports(n int) bool {
+	switch a {
+	case Radix2, SplitRadix:
+		return n >= 1 && dsputils.IsPowerOf2(n)
+	}
+	return n >= 0
+}
+
+// checkLength returns an error wrapping dsputils.ErrBadLength if the built-in
+// engine is in use and the algorithm set by SetAlgorithm cannot transform
+// length n.
+func checkLength(n int) error {
+	if _, ok := currentBackend().(builtinBackend); !ok || n <= 1 {
+		return nil
+	}
+
+	radix2Lock.RLock()
+	a := fft_algorithm
+	radix2Lock.RUnlock()
+
+	if !a.Supports(n) {
+		return fmt.Errorf("fft: length %d with a power of 2 algorithm: %w", n, dsputils.ErrBadLength)
+	}
+	return nil
+}
+
+// algorithmFor returns the algorithm to use for a transform of length n > 1.
+func algorithmFor(n int) Algorithm {
+	radix2Lock.RLock()
+	a := fft_algorithm
+	radix2Lock.RUnlock()
+
+	if a == Auto {
+		if dsputils.IsPowerOf2(n) {
+			return Radix2
+		}
+		return Bluestein
+	}
+	if !a.Supports(n) {
+		panic("length not a power of 2")
+	}
+	return a
+}
+
+// splitRadixFFT returns the FFT of x, whose length is a power of 2,
+// calculated using the split-radix algorithm: the even samples are
+// transformed at half length, and the samples 1 and 3 modulo 4 at quarter
+// length.
+func splitRadixFFT(x []complex128) []complex128 {
+	n := len(x)
+	r := make([]complex128, n)
+	switch n {
+	case 1:
+		r[0] = x[0]
+		return r
+	case 2:
+		r[0], r[1] = x[0]+x[1], x[0]-x[1]
+		return r
+	}
+
+	even := make([]complex128, n/2)
+	odd1 := make([]complex128, n/4)
+	odd3 := make([]complex128, n/4)
+	for i := range even {
+		even[i] = x[2*i]
+	}
+	for i := range odd1 {
+		odd1[i] = x[4*i+1]
+		odd3[i] = x[4*i+3]
+	}
+	u := splitRadixFFT(even)
+	z1 := splitRadixFFT(odd1)
+	z3 := splitRadixFFT(odd3)
+
+	w := getRadix2Factors(n)
+	for k := range n / 4 {
+		a := w[k] * z1[k]
+		b := w[3*k] * z3[k]
+		s, d := a+b, complex(imag(a-b), -real(a-b)) // -i(a-b)
+
+		r[k] = u[k] + s
+		r[k+n/2] = u[k] - s
+		r[k+n/4] = u[k+n/4] + d
+		r[k+3*n/4] = u[k+n/4] - d
+	}
+
+	return r
+}
+
+// mixedRadixFFT returns the FFT of x calculated using the Cooley-Tukey
+// algorithm with its smallest prime factor p as the radix: the p
+// decimated subsequences are transformed recursively and combined with
+// p-point DFTs.
+func mixedRadixFFT(x []complex128) []complex128 {
+	n := len(x)
+	if n == 1 {
+		return []complex128{x[0]}
+	}
+
+	p := smallestFactor(n)
+	m := n / p
+
+	// sub[q] is the FFT of x[q], x[q+p], x[q+2p], ...
+	sub := make([][]complex128, p)
+	if m > 1 {
+		t := make([]complex128, m)
+		for q := range sub {
+			for i := range t {
+				t[i] = x[q+i*p]
+			}
+			sub[q] = mixedRadixFFT(t)
+		}
+	} else {
+		for q := range sub {
+			sub[q] = x[q : q+1]
+		}
+	}
+
+	r := make([]complex128, n)
+	for k := range r {
+		var s complex128
+		for q, y := range sub {
+			sin, cos := math.Sincos(-2 * math.Pi * float64(q*k%n) / float64(n))
+			s += y[k%m] * complex(cos, sin)
+		}
+		r[k] = s
+	}
+
+	return r
+}
+
+// smallestFactor returns the smallest prime factor of n > 1.
+func smallestFactor(n int) int {
+	if n%2 == 0 {
+		return 2
+	}
+	for p := 3; p*p <= n; p += 2 {
+		if n%p == 0 {
+			return p
+		}
+	}
+	return n
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestSetAlgorithm(t *testing.T) {
+	defer SetAlgorithm(Auto)
+
+	sizes := []int{1, 2, 4, 8, 12, 64, 97, 100, 1024}
+	for _, a := range []Algorithm{Auto, Radix2, SplitRadix, MixedRadix, Bluestein} {
+		SetAlgorithm(a)
+		for _, n := range sizes {
+			x := randomMatrix(1, n, int64(n))[0]
+
+			if !a.Supports(n) {
+				if n > 1 && dsputils.IsPowerOf2(n) {
+					t.Error("Supports error\nalgorithm:", a, "\nlength:", n)
+				}
+				func() {
+					defer func() {
+						if recover() == nil {
+							t.Error("SetAlgorithm error\nalgorithm:", a, "\nlength:", n, "\nexpected panic")
+						}
+					}()
+					FFT(x)
+				}()
+				if _, err := FFTE(x); !errors.Is(err, dsputils.ErrBadLength) {
+					t.Error("FFTE error\nalgorithm:", a, "\nlength:", n, "\noutput:", err)
+				}
+				if _, err := IFFTE(x); !errors.Is(err, dsputils.ErrBadLength) {
+					t.Error("IFFTE error\nalgorithm:", a, "\nlength:", n, "\noutput:", err)
+				}
+				continue
+			}
+
+			// FFTStrided uses the same algorithm as FFT, so the results are
+			// identical
+			e := FFT(x)
+			v := make([]complex128, n)
+			FFTStrided(v, x, 1, 0)
+			for i := range v {
+				if v[i] != e[i] {
+					t.Error("FFTStrided error\nalgorithm:", a, "\nlength:", n, "\noutput:", v, "\nexpected:", e)
+					break
+				}
+			}
+			if v, err := FFTE(x); err != nil || !dsputils.PrettyCloseC(v, e) {
+				t.Error("FFTE error\nalgorithm:", a, "\nlength:", n, "\noutput:", v, err, "\nexpected:", e)
+			}
+
+			if v, e := FFT(x), DFT(x); !dsputils.PrettyCloseC(v, e) {
+				t.Error("FFT error\nalgorithm:", a, "\nlength:", n, "\noutput:", v, "\nexpected:", e)
+			}
+			if v, e := IFFT(x), IDFT(x); !dsputils.PrettyCloseC(v, e) {
+				t.Error("IFFT error\nalgorithm:", a, "\nlength:", n, "\noutput:", v, "\nexpected:", e)
+			}
+		}
+	}
+}
+
+func BenchmarkAlgorithms(b *testing.B) {
+	defer SetAlgorithm(Auto)
+
+	x := randomMatrix(1, 1<<12, 1)[0]
+	for _, a := range []struct {
+		name string
+		algo Algorithm
+	}{{"Radix2", Radix2}, {"SplitRadix", SplitRadix}, {"MixedRadix", MixedRadix}, {"Bluestein", Bluestein}} {
+		b.Run(a.name, func(b *testing.B) {
+			SetAlgorithm(a.algo)
+			for b.Loop() {
+				FFT(x)
+			}
+		})
+	}
+}
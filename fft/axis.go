@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+// FFTAxis returns the forward FFT of data along one axis: every 1-dimensional
+// slice of data along axis axis is transformed, for all combinations of the
+// indexes of the other axes. data holds an array of dimensions dims in
+// row-major order, as for dsputils.MakeMatrix, so axis len(dims)-1 is
+// contiguous. Transforming along every axis in turn gives FFTN.
+func FFTAxis(data []complex128, dims []int, axis int) []complex128 {
+	return computeFFTAxis(data, dims, axis, FFT)
+}
+
+// IFFTAxis returns the inverse FFT of data along one axis, like FFTAxis.
+func IFFTAxis(data []complex128, dims []int, axis int) []complex128 {
+	return computeFFTAxis(data, dims, axis, IFFT)
+}
+
+func computeFFTAxis(data []complex128, dims []int, axis int, fftFunc func([]complex128) []complex128) []complex128 {
+	if axis < 0 || axis >= len(dims) {
+		panic("invalid axis")
+	}
+	length := 1
+	for _, d := range dims {
+		if d < 1 {
+			panic("invalid dimensions")
+		}
+		length *= d
+	}
+	if len(data) != length {
+		panic("data length does not match dimensions")
+	}
+
+	// element k of the slice at (outer, inner) is at
+	// outer*n*stride + k*stride + inner
+	n := dims[axis]
+	stride := 1
+	for _, d := range dims[axis+1:] {
+		stride *= d
+	}
+
+	r := make([]complex128, length)
+	if stride == 1 {
+		for o := 0; o < length; o += n {
+			copy(r[o:], fftFunc(data[o:o+n]))
+		}
+		return r
+	}
+
+	line := make([]complex128, n)
+	for o := 0; o < length; o += n * stride {
+		for i := range stride {
+			for k := range line {
+				line[k] = data[o+k*stride+i]
+			}
+			for k, v := range fftFunc(line) {
+				r[o+k*stride+i] = v
+			}
+		}
+	}
+
+	return r
+}
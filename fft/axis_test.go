@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestFFTAxis(t *testing.T) {
+	x := randomMatrix(6, 10, 1)
+	dims := []int{6, 10}
+	data := make([]complex128, 0, 60)
+	for _, row := range x {
+		data = append(data, row...)
+	}
+
+	rows := FFTAxis(data, dims, 1)
+	for i, row := range x {
+		if e := FFT(row); !dsputils.PrettyCloseC(rows[i*10:(i+1)*10], e) {
+			t.Error("FFTAxis rows error\nrow:", i, "\noutput:", rows[i*10:(i+1)*10], "\nexpected:", e)
+		}
+	}
+
+	cols := FFTAxis(data, dims, 0)
+	for j := range 10 {
+		col, o := make([]complex128, 6), make([]complex128, 6)
+		for i := range col {
+			col[i], o[i] = x[i][j], cols[i*10+j]
+		}
+		if e := FFT(col); !dsputils.PrettyCloseC(o, e) {
+			t.Error("FFTAxis columns error\ncolumn:", j, "\noutput:", o, "\nexpected:", e)
+		}
+	}
+
+	both := FFTAxis(rows, dims, 0)
+	for i, e := range FFT2(x) {
+		if o := both[i*10 : (i+1)*10]; !dsputils.PrettyCloseC(o, e) {
+			t.Error("FFTAxis FFT2 error\nrow:", i, "\noutput:", o, "\nexpected:", e)
+		}
+	}
+	if o := IFFTAxis(IFFTAxis(both, dims, 1), dims, 0); !dsputils.PrettyCloseC(o, data) {
+		t.Error("IFFTAxis error\noutput:", o, "\nexpected:", data)
+	}
+
+	// all axes of a 3-dimensional array give FFTN
+	dims = []int{3, 4, 5}
+	data = randomMatrix(1, 60, 2)[0]
+	o := data
+	for axis := range dims {
+		o = FFTAxis(o, dims, axis)
+	}
+	if e := FFTN(dsputils.MakeMatrix(data, dims)); !e.PrettyClose(dsputils.MakeMatrix(o, dims)) {
+		t.Error("FFTAxis FFTN error\noutput:", o, "\nexpected:", e)
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+// FFTBackend computes forward and inverse FFTs. It allows FFT and IFFT, and
+// the functions built on them, to be computed by another implementation,
+// such as a GPU or FFTW library.
+//
+// dst and src have equal lengths. The inverse transform must be scaled by
+// 1/len(src), like IFFT.
+type FFTBackend interface {
+	FFT(dst, src []complex128)
+	IFFT(dst, src []complex128)
+}
+
+var (
+	backend FFTBackend = builtinBackend{}
+)
+
+// SetBackend sets the backend used by FFT and IFFT.
+// If b is nil, the built-in radix-2 and Bluestein engine (the default) is
+// restored. It is safe to call concurrently with transforms, each of which
+// uses the backend set when it starts.
+func SetBackend(b FFTBackend) {
+	if b == nil {
+		b = builtinBackend{}
+	}
+
+	radix2Lock.Lock()
+	backend = b
+	radix2Lock.Unlock()
+}
+
+// currentBackend returns the backend set by SetBackend.
+func currentBackend() FFTBackend {
+	radix2Lock.RLock()
+	defer radix2Lock.RUnlock()
+	return backend
+}
+
+// builtinBackend is the FFTBackend using this package's radix-2 and
+// Bluestein implementations.
+type builtinBackend struct{}
+
+func (builtinBackend) FFT(dst, src []complex128) {
+	copy(dst, computeFFT(src))
+}
+
+func (builtinBackend) IFFT(dst, src []complex128) {
+	copy(dst, computeIFFT(src))
+}
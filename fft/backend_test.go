@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// mockBackend counts calls and delegates to the built-in backend.
+type mockBackend struct {
+	ffts, iffts int
+}
+
+func (m *mockBackend) FFT(dst, src []complex128) {
+	m.ffts++
+	builtinBackend{}.FFT(dst, src)
+}
+
+func (m *mockBackend) IFFT(dst, src []complex128) {
+	m.iffts++
+	builtinBackend{}.IFFT(dst, src)
+}
+
+func TestSetBackend(t *testing.T) {
+	m := &mockBackend{}
+	SetBackend(m)
+	defer SetBackend(nil)
+
+	x := []complex128{1, 2, 3, 4, 5}
+	v := FFT(x)
+	IFFT(v)
+	Convolve(x, x)
+
+	if m.ffts != 3 || m.iffts != 2 {
+		t.Error("backend error\nffts:", m.ffts, "iffts:", m.iffts, "\nexpected: 3 2")
+	}
+
+	SetBackend(nil)
+	if vi := IFFT(FFT(x)); !dsputils.PrettyCloseC(vi, x) {
+		t.Error("default backend error\noutput:", vi, "\nexpected:", x)
+	}
+	if m.ffts != 3 || m.iffts != 2 {
+		t.Error("backend not restored\nffts:", m.ffts, "iffts:", m.iffts, "\nexpected: 3 2")
+	}
+}
+
+func TestSetBackendConcurrent(t *testing.T) {
+	defer SetBackend(nil)
+
+	// run with -race to check that switching backends during transforms is
+	// safe
+	x := []complex128{1, 2, 3, 4, 5, 6, 7, 8}
+	done := make(chan struct{})
+	go func() {
+		for range 100 {
+			SetBackend(&mockBackend{})
+			SetBackend(nil)
+		}
+		close(done)
+	}()
+	for range 100 {
+		if v := IFFT(FFT(x)); !dsputils.PrettyCloseC(v, x) {
+			t.Error("concurrent backend error\noutput:", v, "\nexpected:", x)
+			break
+		}
+	}
+	<-done
+}
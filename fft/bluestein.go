@@ -24,70 +24,110 @@ import (
 )
 
 var (
-	bluesteinLock       sync.RWMutex
-	bluesteinFactors    = map[int][]complex128{}
-	bluesteinInvFactors = map[int][]complex128{}
+	bluesteinLock  sync.RWMutex
+	bluesteinPlans = map[int]*bluesteinPlan{}
 )
 
-func getBluesteinFactors(input_len int) ([]complex128, []complex128) {
+// bluesteinPlan holds the precomputed values for Bluestein FFTs of one length.
+type bluesteinPlan struct {
+	factors, invFactors []complex128
+
+	// chirp is the FFT of the chirp sequence convolved with the input.
+	chirp []complex128
+}
+
+func getBluesteinPlan(input_len int) *bluesteinPlan {
 	bluesteinLock.RLock()
 
-	if hasBluesteinFactors(input_len) {
+	if p := bluesteinPlans[input_len]; p != nil {
 		defer bluesteinLock.RUnlock()
-		return bluesteinFactors[input_len], bluesteinInvFactors[input_len]
+		return p
 	}
 
 	bluesteinLock.RUnlock()
 	bluesteinLock.Lock()
 	defer bluesteinLock.Unlock()
 
-	if !hasBluesteinFactors(input_len) {
-		bluesteinFactors[input_len] = make([]complex128, input_len)
-		bluesteinInvFactors[input_len] = make([]complex128, input_len)
-
-		var sin, cos float64
-		for i := 0; i < input_len; i++ {
-			if i == 0 {
-				sin, cos = 0, 1
-			} else {
-				sin, cos = math.Sincos(math.Pi / float64(input_len) * float64(i*i))
-			}
-			bluesteinFactors[input_len][i] = complex(cos, sin)
-			bluesteinInvFactors[input_len][i] = complex(cos, -sin)
+	if p := bluesteinPlans[input_len]; p != nil {
+		return p
+	}
+
+	p := &bluesteinPlan{
+		factors:    make([]complex128, input_len),
+		invFactors: make([]complex128, input_len),
+	}
+
+	var sin, cos float64
+	for i := 0; i < input_len; i++ {
+		if i == 0 {
+			sin, cos = 0, 1
+		} else {
+			sin, cos = math.Sincos(math.Pi / float64(input_len) * float64(i*i))
 		}
+		p.factors[i] = complex(cos, sin)
+		p.invFactors[i] = complex(cos, -sin)
 	}
 
-	return bluesteinFactors[input_len], bluesteinInvFactors[input_len]
-}
+	la := dsputils.NextPowerOf2(input_len*2 - 1)
+	b := make([]complex128, la)
+	for i := 0; i < input_len; i++ {
+		b[i] = p.factors[i]
+
+		if i != 0 {
+			b[la-i] = p.factors[i]
+		}
+	}
+	p.chirp = radix2FFT(b)
 
-func hasBluesteinFactors(idx int) bool {
-	return bluesteinFactors[idx] != nil
+	bluesteinPlans[input_len] = p
+	return p
 }
 
 // bluesteinFFT returns the FFT calculated using the Bluestein algorithm.
 func bluesteinFFT(x []complex128) []complex128 {
 	lx := len(x)
-	a := dsputils.ZeroPad(x, dsputils.NextPowerOf2(lx*2-1))
-	la := len(a)
-	factors, invFactors := getBluesteinFactors(lx)
+	p := getBluesteinPlan(lx)
 
+	a := make([]complex128, len(p.chirp))
 	for n, v := range x {
-		a[n] = v * invFactors[n]
+		a[n] = v * p.invFactors[n]
 	}
 
-	b := make([]complex128, la)
+	a = radix2FFT(a)
+	for i, v := range p.chirp {
+		a[i] *= v
+	}
+	r := radix2IFFT(a)
+
 	for i := 0; i < lx; i++ {
-		b[i] = factors[i]
+		r[i] *= p.invFactors[i]
+	}
 
-		if i != 0 {
-			b[la-i] = factors[i]
-		}
+	return r[:lx]
+}
+
+// bluesteinIFFT returns the inverse FFT calculated using the Bluestein
+// algorithm with the conjugate chirp, scaled by 1/len(x).
+func bluesteinIFFT(x []complex128) []complex128 {
+	lx := len(x)
+	p := getBluesteinPlan(lx)
+
+	a := make([]complex128, len(p.chirp))
+	for n, v := range x {
+		a[n] = v * p.factors[n]
 	}
 
-	r := Convolve(a, b)
+	// The chirp sequence is symmetric, so the FFT of its conjugate is the
+	// conjugate of its FFT.
+	a = radix2FFT(a)
+	for i, v := range p.chirp {
+		a[i] *= complex(real(v), -imag(v))
+	}
+	r := radix2IFFT(a)
 
+	N := complex(float64(lx), 0)
 	for i := 0; i < lx; i++ {
-		r[i] *= invFactors[i]
+		r[i] *= p.factors[i] / N
 	}
 
 	return r[:lx]
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+)
+
+// Channelizer splits a real-valued stream into uniformly spaced complex
+// subband channels with a polyphase DFT filter bank. Channel k of M is
+// centered on the frequency k/M cycles per sample (channels above M/2 have
+// negative frequencies, and mirror the ones below for real input) and is
+// fs/M wide. Each channel is decimated by M/2, which oversamples it by 2 so
+// that it can be reconstructed by a Synthesizer without aliasing.
+//
+// Each output sample is computed by filtering the input with the M
+// polyphase components of a lowpass prototype, followed by one M-point FFT
+// for all channels. The filtering evaluates all M*tapsPerChannel+1 prototype
+// taps for each output, once every M/2 input samples, at a cost of about
+// 2*tapsPerChannel multiplications per input sample.
+type Channelizer struct {
+	m, dec int
+	h      []float64
+
+	buf []float64 // input samples needed by the next output
+	off int       // stream index of buf[0]
+	t   int       // stream index of the last input of the next output
+}
+
+// NewChannelizer returns a Channelizer with channels subbands, which must be
+// even, and a prototype filter of about tapsPerChannel taps per channel.
+// More taps give sharper channel edges and less leakage between channels; 16
+// gives about 70 dB of stopband attenuation.
+func NewChannelizer(channels, tapsPerChannel int) *Channelizer {
+	if channels < 2 || channels%2 == 1 {
+		panic("invalid number of channels")
+	}
+	if tapsPerChannel < 1 {
+		panic("invalid taps per channel")
+	}
+
+	return &Channelizer{
+		m:   channels,
+		dec: channels / 2,
+		h:   prototype(channels, tapsPerChannel, 1/float64(channels)),
+	}
+}
+
+// prototype returns the Blackman-windowed sinc lowpass filter of length
+// m*taps+1 with a bandwidth of bw cycles per sample (a cutoff of bw/2) and
+// unit gain at DC. Its odd length puts the center on a sample, so when bw is
+// 1/m it is a Nyquist filter: every m-th tap from the center is zero, and its
+// shifts by multiples of 1/m sum to a flat response.
+// The Blackman window is computed here, like window.Blackman, so that this
+// package does not import window, which uses the FFT.
+func prototype(m, taps int, bw float64) []float64 {
+	n := m * taps
+	h := make([]float64, n+1)
+	for i := range h {
+		// window.Blackman(n+1)[i]
+		x := 2 * math.Pi * float64(i) / float64(n)
+		w := 0.42 - 0.5*math.Cos(x) + 0.08*math.Cos(2*x)
+		h[i] = w * bw * sincPi(bw*float64(i-n/2))
+	}
+	return h
+}
+
+func sincPi(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// Channels returns the number of channels of c.
+func (c *Channelizer) Channels() int {
+	return c.m
+}
+
+// Decimation returns the number of input samples per channel output sample.
+func (c *Channelizer) Decimation() int {
+	return c.dec
+}
+
+// Process returns the channel outputs available after the block in, indexed
+// by channel and then time. The total output of successive calls is
+// independent of how the stream is split into blocks.
+func (c *Channelizer) Process(block []float64) [][]complex128 {
+	c.buf = append(c.buf, block...)
+	end := c.off + len(c.buf)
+	lh := len(c.h)
+
+	r := make([][]complex128, c.m)
+	u := make([]complex128, c.m)
+	for ; c.t < end; c.t += c.dec {
+		// u[p] sums the inputs n = p (mod m) filtered by the prototype
+		clear(u)
+		for n := max(c.t-lh+1, c.off); n <= c.t; n++ {
+			u[n%c.m] += complex(c.buf[n-c.off]*c.h[c.t-n], 0)
+		}
+
+		// modulating input n by exp(-2πikn/m) is the DFT over n mod m
+		for k, v := range FFT(u) {
+			r[k] = append(r[k], v)
+		}
+	}
+
+	// drop inputs that no later output needs
+	if keep := c.t - lh + 1; keep > c.off {
+		n := min(keep-c.off, len(c.buf))
+		c.buf = append(c.buf[:0], c.buf[n:]...)
+		c.off += n
+	}
+
+	return r
+}
+
+// Synthesizer recombines the channels of a Channelizer into a wideband
+// real-valued stream. The channels are interpolated by M/2, modulated back
+// to their center frequencies and summed. The prototype of the Channelizer
+// is a Nyquist filter, whose shifted copies sum to a flat response, so with
+// enough taps per channel the output is the input delayed by Delay samples,
+// with an error near the level of the prototype's stopband.
+type Synthesizer struct {
+	m, dec int
+	g      []float64
+
+	acc  []float64 // pending output, starting at the next output sample
+	step int       // channel sample index of the next input
+}
+
+// NewSynthesizer returns a Synthesizer for the outputs of a Channelizer with
+// the same arguments.
+func NewSynthesizer(channels, tapsPerChannel int) *Synthesizer {
+	if channels < 2 || channels%2 == 1 {
+		panic("invalid number of channels")
+	}
+	if tapsPerChannel < 1 {
+		panic("invalid taps per channel")
+	}
+
+	// The interpolation filter passes everything the analysis prototype
+	// does, and rejects the images at multiples of 2/channels.
+	g := prototype(channels, tapsPerChannel, 2/float64(channels))
+	dec := channels / 2
+	for i := range g {
+		g[i] *= float64(dec)
+	}
+
+	return &Synthesizer{
+		m:   channels,
+		dec: dec,
+		g:   g,
+		acc: make([]float64, len(g)),
+	}
+}
+
+// Delay returns the number of samples by which the output of a Channelizer
+// followed by s lags its input.
+func (s *Synthesizer) Delay() int {
+	return len(s.g) - 1
+}
+
+// Process returns the output for the channel samples in, indexed by channel
+// and then time as returned by Channelizer.Process: Decimation samples for
+// each time step. The total output of successive calls is independent of
+// how the stream is split into blocks.
+func (s *Synthesizer) Process(in [][]complex128) []float64 {
+	if len(in) != s.m {
+		panic("invalid number of channels")
+	}
+	steps := len(in[0])
+	for _, ch := range in {
+		if len(ch) != steps {
+			panic("channels not of equal length")
+		}
+	}
+
+	r := make([]float64, 0, steps*s.dec)
+	y := make([]complex128, s.m)
+	for j := range steps {
+		for k := range y {
+			y[k] = in[k][j]
+		}
+
+		// the sum over k of y[k]*exp(2πikn/m) is m times the inverse DFT at
+		// n mod m, for the stream index n of each output
+		v := IFFT(y)
+		n0 := s.step * s.dec
+		for i, g := range s.g {
+			s.acc[i] += g * float64(s.m) * real(v[(n0+i)%s.m])
+		}
+		s.step++
+
+		r = append(r, s.acc[:s.dec]...)
+		copy(s.acc, s.acc[s.dec:])
+		clear(s.acc[len(s.acc)-s.dec:])
+	}
+
+	return r
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestChannelizer(t *testing.T) {
+	const m, taps = 8, 16
+
+	for _, f := range []float64{3.0 / m, 3.0/m + 0.02, 3.0/m - 0.03} {
+		x := make([]float64, 4096)
+		for i := range x {
+			x[i] = math.Cos(2*math.Pi*f*float64(i) + 0.4)
+		}
+
+		c := NewChannelizer(m, taps)
+		y := c.Process(x)
+		if len(y) != m || len(y[0]) != len(x)/c.Decimation() {
+			t.Error("Channelizer shape error\noutput:", len(y), len(y[0]), "\nexpected:", m, len(x)/c.Decimation())
+			continue
+		}
+
+		// a real tone is split between its channel and the mirror channel,
+		// with half the amplitude in each
+		for k, ch := range y {
+			var p float64
+			steady := ch[2*taps:]
+			for _, v := range steady {
+				p += real(v)*real(v) + imag(v)*imag(v)
+			}
+			p /= float64(len(steady))
+
+			if k == 3 || k == m-3 {
+				if math.Abs(p-0.25) > 1e-3 {
+					t.Error("Channelizer error\nfrequency:", f, "\nchannel:", k, "\noutput:", p, "\nexpected:", 0.25)
+				}
+			} else if p > 0.25e-6 {
+				// more than 60 dB down
+				t.Error("Channelizer leakage error\nfrequency:", f, "\nchannel:", k, "\noutput:", p)
+			}
+		}
+	}
+}
+
+func TestChannelizerBlocks(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := randomReal(1000, 1)
+	e := NewChannelizer(4, 8).Process(x)
+
+	c := NewChannelizer(4, 8)
+	y := make([][]complex128, 4)
+	for len(x) > 0 {
+		n := min(rnd.Intn(10)+1, len(x))
+		for k, ch := range c.Process(x[:n]) {
+			y[k] = append(y[k], ch...)
+		}
+		x = x[n:]
+	}
+
+	for k := range e {
+		if !dsputils.PrettyCloseC(y[k], e[k]) {
+			t.Error("Channelizer blocks error\nchannel:", k)
+		}
+	}
+}
+
+func TestSynthesizer(t *testing.T) {
+	for _, tt := range []struct{ m, taps int }{{2, 16}, {8, 16}, {16, 24}} {
+		x := randomReal(3000, int64(tt.m))
+
+		c := NewChannelizer(tt.m, tt.taps)
+		s := NewSynthesizer(tt.m, tt.taps)
+
+		// in blocks, to exercise the stream state
+		var y []float64
+		for i := 0; i < len(x); i += 100 * tt.m {
+			y = append(y, s.Process(c.Process(x[i:min(i+100*tt.m, len(x))]))...)
+		}
+
+		d := s.Delay()
+		var num, den float64
+		for i := 2 * d; i < len(y); i++ {
+			e := x[i-d]
+			num += (y[i] - e) * (y[i] - e)
+			den += e * e
+		}
+		if snr := 10 * math.Log10(den/num); snr < 60 {
+			t.Error("Synthesizer reconstruction error\nchannels:", tt.m, "\noutput:", snr, "dB\nexpected: > 60 dB")
+		}
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// FFTConvolver convolves signals with a fixed real-valued kernel using the
+// overlap-add method. The FFT of the kernel is computed once, so reusing an
+// FFTConvolver across many signals avoids recomputing it.
+type FFTConvolver struct {
+	klen, block, nfft int
+	kf                []complex128
+}
+
+// NewFFTConvolver returns an FFTConvolver for kernel, which processes signals
+// in blocks of blockSize samples.
+// If blockSize is 0, OptimalBlockSize(len(kernel)) is used.
+func NewFFTConvolver(kernel []float64, blockSize int) *FFTConvolver {
+	if len(kernel) == 0 {
+		panic("empty kernel")
+	}
+	if blockSize == 0 {
+		blockSize = OptimalBlockSize(len(kernel))
+	}
+	if blockSize < 1 {
+		panic("invalid block size")
+	}
+
+	nfft := dsputils.NextPowerOf2(blockSize + len(kernel) - 1)
+	return &FFTConvolver{
+		klen:  len(kernel),
+		block: blockSize,
+		nfft:  nfft,
+		kf:    FFT(dsputils.ZeroPad(dsputils.ToComplex(kernel), nfft)),
+	}
+}
+
+// Convolve returns the full linear convolution of signal with the kernel,
+// which has length len(signal)+len(kernel)-1.
+func (c *FFTConvolver) Convolve(signal []float64) []float64 {
+	if len(signal) == 0 {
+		return []float64{}
+	}
+
+	r := make([]float64, len(signal)+c.klen-1)
+	t := make([]complex128, c.nfft)
+
+	for start := 0; start < len(signal); start += c.block {
+		end := min(start+c.block, len(signal))
+
+		for i := range t {
+			t[i] = 0
+		}
+		for i, v := range signal[start:end] {
+			t[i] = complex(v, 0)
+		}
+
+		tf := FFT(t)
+		for i := range tf {
+			tf[i] *= c.kf[i]
+		}
+
+		y := IFFT(tf)
+		for i, n := 0, end-start+c.klen-1; i < n; i++ {
+			r[start+i] += real(y[i])
+		}
+	}
+
+	return r
+}
+
+// OptimalBlockSize returns the overlap-add block size for a kernel of length
+// kernelLen that minimizes the estimated FFT cost per output sample. The
+// block size is chosen so that the block plus the kernel tail exactly fills a
+// power of 2 FFT length of at least 64, to amortize the per-block overhead.
+func OptimalBlockSize(kernelLen int) int {
+	if kernelLen < 1 {
+		panic("invalid kernel length")
+	}
+
+	best, bestCost := 0, math.Inf(1)
+	for n := dsputils.NextPowerOf2(max(kernelLen, 64)); n <= 1<<24; n <<= 1 {
+		block := n - kernelLen + 1
+		if c := blockCost(n, block); c < bestCost {
+			best, bestCost = block, c
+		}
+	}
+
+	return best
+}
+
+// blockCost returns the estimated cost per output sample of overlap-add with
+// an FFT length of n and block size of block: one forward and one inverse
+// FFT of n*log2(n) operations each, plus n multiplications.
+func blockCost(n, block int) float64 {
+	return (2*float64(n)*math.Log2(float64(n)) + float64(n)) / float64(block)
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// Convolve2D returns the 2-dimensional linear convolution of image ∗ kernel,
+// computed with 2-dimensional FFTs zero-padded to powers of 2. mode selects
+// the returned part of the result along each axis, as in dsputils.Convolve:
+// Same returns a result the size of image. image and kernel must be
+// rectangular.
+func Convolve2D(image, kernel [][]float64, mode dsputils.ConvMode) [][]float64 {
+	ir, ic := dims2(image)
+	kr, kc := dims2(kernel)
+	if ir == 0 || ic == 0 || kr == 0 || kc == 0 {
+		return [][]float64{}
+	}
+
+	rows := dsputils.NextPowerOf2(ir + kr - 1)
+	cols := dsputils.NextPowerOf2(ic + kc - 1)
+
+	a := FFT2(pad2(image, rows, cols))
+	b := FFT2(pad2(kernel, rows, cols))
+	for i := range a {
+		for j := range a[i] {
+			a[i][j] *= b[i][j]
+		}
+	}
+	c := IFFT2(a)
+
+	r0, nr := convRange(ir, kr, mode)
+	c0, nc := convRange(ic, kc, mode)
+	r := make([][]float64, nr)
+	for i := range r {
+		r[i] = make([]float64, nc)
+		for j := range r[i] {
+			r[i][j] = real(c[r0+i][c0+j])
+		}
+	}
+
+	return r
+}
+
+// dims2 returns the dimensions of the rectangular matrix x.
+func dims2(x [][]float64) (rows, cols int) {
+	if len(x) == 0 {
+		return 0, 0
+	}
+	cols = len(x[0])
+	for _, v := range x {
+		if len(v) != cols {
+			panic("ragged input array")
+		}
+	}
+	return len(x), cols
+}
+
+// pad2 returns x as a complex rows x cols matrix, zero-padded at the end of
+// each axis.
+func pad2(x [][]float64, rows, cols int) [][]complex128 {
+	r := make([][]complex128, rows)
+	for i := range r {
+		r[i] = make([]complex128, cols)
+		if i < len(x) {
+			for j, v := range x[i] {
+				r[i][j] = complex(v, 0)
+			}
+		}
+	}
+	return r
+}
+
+// convRange returns the start and length of the part of a full convolution
+// of sequences of lengths la and lb selected by mode.
+func convRange(la, lb int, mode dsputils.ConvMode) (start, n int) {
+	switch mode {
+	case dsputils.Full:
+		return 0, la + lb - 1
+	case dsputils.Same:
+		return (lb - 1) / 2, la
+	case dsputils.Valid:
+		return min(la, lb) - 1, max(la, lb) - min(la, lb) + 1
+	}
+
+	panic("unknown convolution mode")
+}
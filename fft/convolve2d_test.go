@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// directConvolve2D returns the full 2-dimensional convolution of x ∗ y.
+func directConvolve2D(x, y [][]float64) [][]float64 {
+	r := make([][]float64, len(x)+len(y)-1)
+	for i := range r {
+		r[i] = make([]float64, len(x[0])+len(y[0])-1)
+	}
+	for i := range x {
+		for j := range x[i] {
+			for k := range y {
+				for l := range y[k] {
+					r[i+k][j+l] += x[i][j] * y[k][l]
+				}
+			}
+		}
+	}
+	return r
+}
+
+func TestConvolve2D(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	image := make([][]float64, 13)
+	for i := range image {
+		image[i] = make([]float64, 9)
+		for j := range image[i] {
+			image[i][j] = rnd.Float64()
+		}
+	}
+	blur := [][]float64{
+		{1.0 / 16, 2.0 / 16, 1.0 / 16},
+		{2.0 / 16, 4.0 / 16, 2.0 / 16},
+		{1.0 / 16, 2.0 / 16, 1.0 / 16},
+	}
+	wide := [][]float64{{1, 2, 3, 4}, {-1, 0, 1, 0.5}}
+
+	for _, kernel := range [][][]float64{blur, wide} {
+		full := directConvolve2D(image, kernel)
+		for _, mode := range []dsputils.ConvMode{dsputils.Full, dsputils.Same, dsputils.Valid} {
+			v := Convolve2D(image, kernel, mode)
+
+			// each axis is trimmed like the 1-D convolution
+			r0, nr := convRange(len(image), len(kernel), mode)
+			c0, nc := convRange(len(image[0]), len(kernel[0]), mode)
+			if len(v) != nr {
+				t.Error("Convolve2D rows error\nmode:", mode, "\noutput:", len(v), "\nexpected:", nr)
+				continue
+			}
+			for i := range v {
+				if e := full[r0+i][c0 : c0+nc]; !dsputils.PrettyClose(v[i], e) {
+					t.Error("Convolve2D error\nmode:", mode, "\nrow:", i, "\noutput:", v[i], "\nexpected:", e)
+					break
+				}
+			}
+
+			if mode == dsputils.Same && (len(v) != len(image) || len(v[0]) != len(image[0])) {
+				t.Error("Convolve2D Same size error\noutput:", len(v), len(v[0]), "\nexpected:", len(image), len(image[0]))
+			}
+		}
+	}
+}
+
+func TestConvolveSeparable(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	image := make([][]float64, 20)
+	for i := range image {
+		image[i] = make([]float64, 17)
+		for j := range image[i] {
+			image[i][j] = rnd.NormFloat64()
+		}
+	}
+	row := []float64{1, 4, 6, 4, 1}
+	col := []float64{-1, 0, 1}
+	kernel := dsputils.Outer(col, row)
+
+	for _, mode := range []dsputils.ConvMode{dsputils.Full, dsputils.Same, dsputils.Valid} {
+		v := dsputils.ConvolveSeparable(image, row, col, mode)
+		e := Convolve2D(image, kernel, mode)
+		if len(v) != len(e) {
+			t.Error("ConvolveSeparable error\nmode:", mode, "\noutput:", len(v), "\nexpected:", len(e))
+			continue
+		}
+		for i := range e {
+			if !dsputils.PrettyClose(v[i], e[i]) {
+				t.Error("ConvolveSeparable error\nmode:", mode, "\nrow:", i, "\noutput:", v[i], "\nexpected:", e[i])
+				break
+			}
+		}
+	}
+}
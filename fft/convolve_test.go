@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// directConvolve returns the full linear convolution of x and y.
+func directConvolve(x, y []float64) []float64 {
+	r := make([]float64, len(x)+len(y)-1)
+	for i, a := range x {
+		for j, b := range y {
+			r[i+j] += a * b
+		}
+	}
+	return r
+}
+
+func TestConvolveReal(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 16, 100} {
+		x, y := randomReal(n, 1), randomReal(n, 2)
+		v := ConvolveReal(x, y)
+
+		// the complex path agrees, with only rounding in its imaginary part
+		c := Convolve(dsputils.ToComplex(x), dsputils.ToComplex(y))
+		e := make([]float64, n)
+		for i, v := range c {
+			e[i] = real(v)
+			if math.Abs(imag(v)) > 1e-12 {
+				t.Error("Convolve imaginary residue\nlength:", n, "\nindex:", i, "\noutput:", imag(v))
+			}
+		}
+		if !dsputils.PrettyClose(v, e) {
+			t.Error("ConvolveReal error\nlength:", n, "\noutput:", v, "\nexpected:", e)
+		}
+	}
+}
+
+func TestFFTConvolver(t *testing.T) {
+	kernel := []float64{0.5, -1, 2, 0.25, 3}
+	signals := [][]float64{
+		{1},
+		{1, 2, 3},
+		{3, -1, 4, 1, -5, 9, 2, -6, 5, 3, -5, 8, 9, 7, -9, 3, 2, 3, 8, 4},
+		{0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+	}
+
+	for _, bs := range []int{0, 1, 3, 4, 16} {
+		c := NewFFTConvolver(kernel, bs)
+
+		// reuse c for each signal to ensure no state is carried between calls
+		for _, s := range signals {
+			v := c.Convolve(s)
+			e := directConvolve(s, kernel)
+			if !dsputils.PrettyClose(v, e) {
+				t.Error("FFTConvolver error\nblock size:", bs, "\ninput:", s, "\noutput:", v, "\nexpected:", e)
+			}
+		}
+	}
+}
+
+func TestOptimalBlockSize(t *testing.T) {
+	for _, m := range []int{1, 2, 5, 64, 100, 1000, 4097} {
+		bs := OptimalBlockSize(m)
+		n := bs + m - 1
+		if bs < 1 || !dsputils.IsPowerOf2(n) {
+			t.Error("OptimalBlockSize error\ninput:", m, "\noutput:", bs)
+			continue
+		}
+
+		// The naive choice of a block the size of the kernel must not be
+		// cheaper, except for short kernels where the FFT length is
+		// limited.
+		naive := dsputils.NextPowerOf2(2*m - 1)
+		if naive >= 64 && blockCost(n, bs) > blockCost(naive, m) {
+			t.Error("OptimalBlockSize cost error\ninput:", m, "\noutput:", bs, "\ncost:", blockCost(n, bs), "\nnaive cost:", blockCost(naive, m))
+		}
+	}
+}
+
+func benchmarkFFTConvolver(b *testing.B, blockSize int) {
+	kernel := make([]float64, 1000)
+	for i := range kernel {
+		kernel[i] = float64(i%7) - 3
+	}
+	signal := make([]float64, 1<<18)
+	for i := range signal {
+		signal[i] = float64(i%13) - 6
+	}
+
+	c := NewFFTConvolver(kernel, blockSize)
+	for b.Loop() {
+		c.Convolve(signal)
+	}
+}
+
+// run with: go test -test.bench="FFTConvolver"
+func BenchmarkFFTConvolverAuto(b *testing.B) {
+	benchmarkFFTConvolver(b, 0)
+}
+
+func BenchmarkFFTConvolverNaive(b *testing.B) {
+	benchmarkFFTConvolver(b, 1000)
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+)
+
+var (
+	flush_denormals = false
+)
+
+// SetFlushDenormals sets whether FFT and IFFT replace subnormal values in
+// their inputs and outputs with zero. It is off by default.
+//
+// Arithmetic on subnormal (denormal) floating-point numbers is much slower than
+// on normal numbers on many CPUs, sometimes by 100x. Signals that decay toward
+// zero, such as filter or reverb tails, can fill the FFT with them. Flushing
+// avoids that cost but changes results by up to the smallest normal float64,
+// so it is opt-in.
+func SetFlushDenormals(on bool) {
+	flush_denormals = on
+}
+
+// flushDenormals sets the subnormal real and imaginary parts of x to zero.
+func flushDenormals(x []complex128) {
+	for i, v := range x {
+		re, im := real(v), imag(v)
+		if isSubnormal(re) || isSubnormal(im) {
+			if isSubnormal(re) {
+				re = 0
+			}
+			if isSubnormal(im) {
+				im = 0
+			}
+			x[i] = complex(re, im)
+		}
+	}
+}
+
+func isSubnormal(f float64) bool {
+	return f != 0 && math.Abs(f) < 0x1p-1022
+}
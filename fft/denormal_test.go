@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+)
+
+// denormals returns n complex values with subnormal real parts.
+func denormals(n int) []complex128 {
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = complex(float64(i%7+1)*0x1p-1060, 0)
+	}
+	return x
+}
+
+func TestFlushDenormals(t *testing.T) {
+	x := denormals(1024)
+
+	SetFlushDenormals(true)
+	defer SetFlushDenormals(false)
+
+	for _, r := range [][]complex128{FFT(x), IFFT(x)} {
+		for i, v := range r {
+			if isSubnormal(real(v)) || isSubnormal(imag(v)) {
+				t.Error("FlushDenormals error\nindex:", i, "\noutput:", v)
+				break
+			}
+		}
+	}
+
+	// the input is left unchanged
+	if !isSubnormal(real(x[0])) {
+		t.Error("FlushDenormals modified input\noutput:", x[0])
+	}
+}
+
+// BenchmarkFlushDenormals compares FFTs of a subnormal input with and without
+// flushing. The difference depends on the CPU's subnormal arithmetic.
+func BenchmarkFlushDenormals(b *testing.B) {
+	x := denormals(1 << 14)
+	for _, on := range []bool{false, true} {
+		name := "Off"
+		if on {
+			name = "On"
+		}
+		b.Run(name, func(b *testing.B) {
+			SetFlushDenormals(on)
+			defer SetFlushDenormals(false)
+			for b.Loop() {
+				FFT(x)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+)
+
+// DFT returns the discrete Fourier transform of x computed directly from its
+// definition, in O(N^2) time. It is exact up to the rounding of each term,
+// for any length, and so is a reference for checking FFT.
+func DFT(x []complex128) []complex128 {
+	return dft(x, -1)
+}
+
+// IDFT returns the inverse discrete Fourier transform of x computed directly
+// from its definition, in O(N^2) time. It is scaled by 1/len(x), like IFFT,
+// and is a reference for checking it.
+func IDFT(x []complex128) []complex128 {
+	return dft(x, 1)
+}
+
+// dft returns the direct DFT of x with the exponent sign sign. The inverse
+// (sign 1) is scaled by 1/len(x).
+func dft(x []complex128, sign float64) []complex128 {
+	n := len(x)
+	r := make([]complex128, n)
+	for k := range r {
+		for j, v := range x {
+			s, c := math.Sincos(sign * 2 * math.Pi * float64(k*j%n) / float64(n))
+			r[k] += v * complex(c, s)
+		}
+		if sign > 0 {
+			r[k] /= complex(float64(n), 0)
+		}
+	}
+	return r
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestIDFT(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 12, 17, 100, 243} {
+		x := randomMatrix(1, n, int64(n))[0]
+
+		if v := IDFT(DFT(x)); !dsputils.PrettyCloseC(v, x) {
+			t.Error("IDFT error\nlength:", n, "\noutput:", v, "\nexpected:", x)
+		}
+		if v, e := DFT(x), FFT(x); !dsputils.PrettyCloseC(v, e) {
+			t.Error("DFT error\nlength:", n, "\noutput:", v, "\nexpected:", e)
+		}
+		if v, e := IDFT(x), IFFT(x); !dsputils.PrettyCloseC(v, e) {
+			t.Error("IDFT normalization error\nlength:", n, "\noutput:", v, "\nexpected:", e)
+		}
+	}
+
+	if v := IDFT(nil); len(v) != 0 {
+		t.Error("IDFT error\noutput:", v, "\nexpected: []")
+	}
+}
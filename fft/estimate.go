@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// EstimateOps returns the approximate number of real floating-point
+// multiplications and additions in an FFT of length n by the built-in engine,
+// for the algorithm FFT uses at that length: by default Radix2 for powers of
+// 2 and Bluestein otherwise, or the one set by SetAlgorithm. Like FFT, it
+// panics if that algorithm cannot transform length n.
+// Cached precomputations (twiddle factors and chirps) are not counted, nor
+// are the twiddle factors that MixedRadix computes as it goes.
+// Bluestein's algorithm costs several times more than a radix-2 FFT of a
+// similar length, so zero-padding to a power of 2 is often worthwhile.
+func EstimateOps(n int) (mults, adds int) {
+	if n <= 1 {
+		return 0, 0
+	}
+
+	switch algorithmFor(n) {
+	case Radix2:
+		return radix2Ops(n)
+	case SplitRadix:
+		return splitRadixOps(n)
+	case MixedRadix:
+		return mixedRadixOps(n)
+	}
+	return bluesteinOps(n)
+}
+
+func radix2Ops(n int) (mults, adds int) {
+	// n/2 butterflies per stage, each with one complex multiplication
+	// (4 real multiplications and 2 additions) and two complex additions
+	stages := bits.Len(uint(n)) - 1
+	return 2 * n * stages, 3 * n * stages
+}
+
+func splitRadixOps(n int) (mults, adds int) {
+	switch n {
+	case 1:
+		return 0, 0
+	case 2:
+		return 0, 4
+	}
+
+	// transforms of length n/2 and two of n/4, then for each of the n/4
+	// outputs of the latter two complex multiplications and 6 complex
+	// additions
+	um, ua := splitRadixOps(n / 2)
+	zm, za := splitRadixOps(n / 4)
+	return um + 2*zm + 2*n, ua + 2*za + 4*n
+}
+
+func mixedRadixOps(n int) (mults, adds int) {
+	if n == 1 {
+		return 0, 0
+	}
+
+	// p transforms of length n/p, then a p-term complex sum of products for
+	// each of the n outputs
+	p := smallestFactor(n)
+	sm, sa := mixedRadixOps(n / p)
+	return p*sm + 4*n*p, p*sa + n*(4*p-2)
+}
+
+func bluesteinOps(n int) (mults, adds int) {
+	// a forward and an inverse FFT of length m, the inverse's 1/m scaling,
+	// and complex multiplications by the chirp before, between and after
+	m := dsputils.NextPowerOf2(2*n - 1)
+	fm, fa := radix2Ops(m)
+	cm := 2*n + m
+	return 2*fm + 2*m + 4*cm, 2*fa + 2*cm
+}
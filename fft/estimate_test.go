@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestEstimateOps(t *testing.T) {
+	for _, tt := range []struct {
+		n, mults, adds int
+	}{
+		{0, 0, 0},
+		{1, 0, 0},
+		{2, 4, 6},
+		{8, 48, 72},
+		{1024, 20480, 30720},
+	} {
+		if m, a := EstimateOps(tt.n); m != tt.mults || a != tt.adds {
+			t.Error("EstimateOps error\ninput:", tt.n, "\noutput:", m, a, "\nexpected:", tt.mults, tt.adds)
+		}
+	}
+
+	// N log2 N scaling: doubling the length slightly more than doubles the cost
+	for n := 4; n <= 1<<20; n <<= 1 {
+		m1, _ := EstimateOps(n)
+		m2, _ := EstimateOps(2 * n)
+		stages := 0
+		for k := n; k > 1; k >>= 1 {
+			stages++
+		}
+		if m2*stages != 2*m1*(stages+1) {
+			t.Error("EstimateOps scaling error\ninput:", n, "\noutput:", m1, m2)
+		}
+	}
+
+	// Bluestein lengths cost more than the next power of 2
+	for _, n := range []int{3, 100, 1000, 1025} {
+		m, a := EstimateOps(n)
+		pm, pa := EstimateOps(dsputils.NextPowerOf2(n))
+		if m <= pm || a <= pa {
+			t.Error("EstimateOps Bluestein error\ninput:", n, "\noutput:", m, a, "\npower of 2:", pm, pa)
+		}
+	}
+}
+
+func TestEstimateOpsAlgorithm(t *testing.T) {
+	defer SetAlgorithm(Auto)
+
+	// split-radix needs fewer multiplications than radix-2
+	SetAlgorithm(SplitRadix)
+	for _, tt := range []struct{ n, mults, adds int }{{2, 0, 4}, {4, 8, 20}, {8, 24, 60}} {
+		if m, a := EstimateOps(tt.n); m != tt.mults || a != tt.adds {
+			t.Error("EstimateOps SplitRadix error\ninput:", tt.n, "\noutput:", m, a, "\nexpected:", tt.mults, tt.adds)
+		}
+	}
+	for n := 8; n <= 1<<16; n <<= 1 {
+		m, _ := EstimateOps(n)
+		if rm, _ := radix2Ops(n); m >= rm {
+			t.Error("EstimateOps SplitRadix error\ninput:", n, "\noutput:", m, "\nradix-2:", rm)
+		}
+	}
+
+	// mixed radix over the factors 2, 2 and 3
+	SetAlgorithm(MixedRadix)
+	if m, a := EstimateOps(12); m != 4*12*(2+2+3) || a != 12*(6+6+10) {
+		t.Error("EstimateOps MixedRadix error\ninput:", 12, "\noutput:", m, a, "\nexpected:", 4*12*7, 12*22)
+	}
+
+	SetAlgorithm(Bluestein)
+	if m, _ := EstimateOps(16); m <= 2*16*4 {
+		t.Error("EstimateOps Bluestein error\ninput:", 16, "\noutput:", m)
+	}
+}
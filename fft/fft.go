@@ -18,6 +18,8 @@
 package fft
 
 import (
+	"fmt"
+
 	"github.com/madelynnblue/go-dsp/dsputils"
 )
 
@@ -33,43 +35,141 @@ func IFFTReal(x []float64) []complex128 {
 
 // IFFT returns the inverse FFT of the complex-valued slice.
 func IFFT(x []complex128) []complex128 {
+	if checkLength(len(x)) != nil {
+		panic("length not a power of 2")
+	}
+	if flush_denormals {
+		x = append([]complex128(nil), x...)
+		flushDenormals(x)
+	}
+	var r []complex128
+	b := currentBackend()
+	if _, ok := b.(builtinBackend); ok {
+		// the built-in engine returns a new slice, so skip the copy
+		r = computeIFFT(x)
+	} else {
+		r = make([]complex128, len(x))
+		b.IFFT(r, x)
+	}
+	if flush_denormals {
+		flushDenormals(r)
+	}
+	return r
+}
+
+// computeIFFT returns the inverse FFT of x using the built-in engine.
+func computeIFFT(x []complex128) []complex128 {
 	lx := len(x)
-	r := make([]complex128, lx)
+	if lx <= 1 {
+		r := make([]complex128, lx)
+		copy(r, x)
+		return r
+	}
 
-	// Reverse inputs, which is calculated with modulo N, hence x[0] as an outlier
-	r[0] = x[0]
-	for i := 1; i < lx; i++ {
-		r[i] = x[lx-i]
+	switch algorithmFor(lx) {
+	case Radix2:
+		return radix2IFFT(x)
+	case Bluestein:
+		return bluesteinIFFT(x)
 	}
 
-	r = FFT(r)
+	// the conjugate trick: conj(FFT(conj(x)))/N
+	r := make([]complex128, lx)
+	for i, v := range x {
+		r[i] = complex(real(v), -imag(v))
+	}
+	r = computeFFT(r)
 
-	N := complex(float64(lx), 0)
-	for n := range r {
-		r[n] /= N
+	N := float64(lx)
+	for i, v := range r {
+		r[i] = complex(real(v)/N, -imag(v)/N)
 	}
 	return r
 }
 
 // Convolve returns the convolution of x ∗ y.
+// It panics if x and y have different lengths; see ConvolveE.
 func Convolve(x, y []complex128) []complex128 {
+	return convolve(x, y, FFT, IFFT)
+}
+
+// ConvolveE is like Convolve, but returns an error wrapping
+// dsputils.ErrLengthMismatch instead of panicking if x and y have different
+// lengths.
+func ConvolveE(x, y []complex128) ([]complex128, error) {
+	if len(x) != len(y) {
+		return nil, fmt.Errorf("fft: convolve lengths %d and %d: %w", len(x), len(y), dsputils.ErrLengthMismatch)
+	}
+
+	return Convolve(x, y), nil
+}
+
+// convolve returns the convolution of x ∗ y computed with the given forward
+// and inverse FFT functions.
+func convolve(x, y []complex128, fftFunc, ifftFunc func([]complex128) []complex128) []complex128 {
 	if len(x) != len(y) {
 		panic("arrays not of equal size")
 	}
 
-	fft_x := FFT(x)
-	fft_y := FFT(y)
+	fft_x := fftFunc(x)
+	fft_y := fftFunc(y)
 
 	r := make([]complex128, len(x))
 	for i := 0; i < len(r); i++ {
 		r[i] = fft_x[i] * fft_y[i]
 	}
 
-	return IFFT(r)
+	return ifftFunc(r)
 }
 
 // FFT returns the forward FFT of the complex-valued slice.
 func FFT(x []complex128) []complex128 {
+	if checkLength(len(x)) != nil {
+		panic("length not a power of 2")
+	}
+	if flush_denormals {
+		x = append([]complex128(nil), x...)
+		flushDenormals(x)
+	}
+	var r []complex128
+	b := currentBackend()
+	if _, ok := b.(builtinBackend); ok {
+		// the built-in engine returns a new slice, so skip the copy
+		r = computeFFT(x)
+	} else {
+		r = make([]complex128, len(x))
+		b.FFT(r, x)
+	}
+	if flush_denormals {
+		flushDenormals(r)
+	}
+	return r
+}
+
+// FFTE is like FFT, but returns an error wrapping dsputils.ErrBadLength
+// instead of panicking if the algorithm set by SetAlgorithm cannot transform
+// len(x).
+func FFTE(x []complex128) ([]complex128, error) {
+	if err := checkLength(len(x)); err != nil {
+		return nil, err
+	}
+
+	return FFT(x), nil
+}
+
+// IFFTE is like IFFT, but returns an error wrapping dsputils.ErrBadLength
+// instead of panicking if the algorithm set by SetAlgorithm cannot transform
+// len(x).
+func IFFTE(x []complex128) ([]complex128, error) {
+	if err := checkLength(len(x)); err != nil {
+		return nil, err
+	}
+
+	return IFFT(x), nil
+}
+
+// computeFFT returns the forward FFT of x using the built-in engine.
+func computeFFT(x []complex128) []complex128 {
 	lx := len(x)
 
 	// todo: non-hack handling length <= 1 cases
@@ -79,8 +179,13 @@ func FFT(x []complex128) []complex128 {
 		return r
 	}
 
-	if dsputils.IsPowerOf2(lx) {
+	switch algorithmFor(lx) {
+	case Radix2:
 		return radix2FFT(x)
+	case SplitRadix:
+		return splitRadixFFT(x)
+	case MixedRadix:
+		return mixedRadixFFT(x)
 	}
 
 	return bluesteinFFT(x)
@@ -88,10 +193,16 @@ func FFT(x []complex128) []complex128 {
 
 var (
 	worker_pool_size = 0
+	min_chunk        = 1
 )
 
 // SetWorkerPoolSize sets the number of workers during FFT computation on multicore systems.
 // If n is 0 (the default), then GOMAXPROCS workers will be created.
+// Setting n to 1 computes each FFT on a single worker, which makes benchmark
+// results independent of machine load; see BenchmarkFFTSizes.
+// The result does not depend on n or on scheduling: the workers compute
+// disjoint butterflies, with no sums across workers, so every run is
+// bit-identical.
 func SetWorkerPoolSize(n int) {
 	if n < 0 {
 		n = 0
@@ -100,6 +211,35 @@ func SetWorkerPoolSize(n int) {
 	worker_pool_size = n
 }
 
+// SetMinChunk sets the minimum number of butterflies computed by each worker
+// job in a stage of a radix-2 FFT. The default is 1, which splits each stage
+// evenly among the workers. Larger values keep small transforms from being
+// spread over more goroutines than their work justifies, at the cost of load
+// balance for larger ones. It does not change the result.
+func SetMinChunk(n int) {
+	if n < 1 {
+		panic("invalid chunk size")
+	}
+	min_chunk = n
+}
+
+// ClearPlanCache releases the twiddle factors and Bluestein chirps cached for
+// each transform length. FFT and IFFT compute and cache these the first time
+// a length is used, so repeated calls at the same length are faster; the
+// cache grows with the number of distinct lengths. It is safe to call
+// concurrently with transforms.
+func ClearPlanCache() {
+	radix2Lock.Lock()
+	radix2Factors = map[int][]complex128{
+		4: radix2Factors[4],
+	}
+	radix2Lock.Unlock()
+
+	bluesteinLock.Lock()
+	bluesteinPlans = map[int]*bluesteinPlan{}
+	bluesteinLock.Unlock()
+}
+
 // FFT2Real returns the 2-dimensional, forward FFT of the real-valued matrix.
 func FFT2Real(x [][]float64) [][]complex128 {
 	return FFT2(dsputils.ToComplex2(x))
@@ -107,7 +247,7 @@ func FFT2Real(x [][]float64) [][]complex128 {
 
 // FFT2 returns the 2-dimensional, forward FFT of the complex-valued matrix.
 func FFT2(x [][]complex128) [][]complex128 {
-	return computeFFT2(x, FFT)
+	return computeFFT2(x, FFT, false)
 }
 
 // IFFT2Real returns the 2-dimensional, inverse FFT of the real-valued matrix.
@@ -117,37 +257,43 @@ func IFFT2Real(x [][]float64) [][]complex128 {
 
 // IFFT2 returns the 2-dimensional, inverse FFT of the complex-valued matrix.
 func IFFT2(x [][]complex128) [][]complex128 {
-	return computeFFT2(x, IFFT)
+	return computeFFT2(x, IFFT, false)
 }
 
-func computeFFT2(x [][]complex128, fftFunc func([]complex128) []complex128) [][]complex128 {
+// computeFFT2 returns the 2-dimensional FFT of x computed with fftFunc. The rows are
+// transformed, then the matrix is transposed so that the columns are also
+// transformed as contiguous rows. If parallel is true, the rows in each pass
+// are transformed concurrently.
+func computeFFT2(x [][]complex128, fftFunc func([]complex128) []complex128, parallel bool) [][]complex128 {
 	rows := len(x)
 	if rows == 0 {
 		panic("empty input array")
 	}
 
 	cols := len(x[0])
-	r := make([][]complex128, rows)
-	for i := 0; i < rows; i++ {
-		if len(x[i]) != cols {
+	data := make([]complex128, rows*cols)
+	for i, v := range x {
+		if len(v) != cols {
 			panic("ragged input array")
 		}
-		r[i] = make([]complex128, cols)
+		copy(data[i*cols:], v)
 	}
 
-	for i := 0; i < cols; i++ {
-		t := make([]complex128, rows)
-		for j := 0; j < rows; j++ {
-			t[j] = x[j][i]
-		}
-
-		for n, v := range fftFunc(t) {
-			r[n][i] = v
+	r := make([][]complex128, rows)
+	if cols == 0 {
+		for i := range r {
+			r[i] = []complex128{}
 		}
+		return r
 	}
 
-	for n, v := range r {
-		r[n] = fftFunc(v)
+	transformRows(data, cols, fftFunc, parallel)
+	data = dsputils.Transpose(data, rows, cols)
+	transformRows(data, rows, fftFunc, parallel)
+	data = dsputils.Transpose(data, cols, rows)
+
+	for i := range r {
+		r[i] = data[i*cols : (i+1)*cols : (i+1)*cols]
 	}
 
 	return r
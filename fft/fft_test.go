@@ -17,9 +17,11 @@
 package fft
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"math/cmplx"
+	"sync"
 	"testing"
 
 	"github.com/madelynnblue/go-dsp/dsputils"
@@ -221,6 +223,19 @@ func TestFFT2(t *testing.T) {
 	}
 }
 
+func TestFFT2Empty(t *testing.T) {
+	x := [][]complex128{{}, {}}
+	for _, f := range []func([][]complex128) [][]complex128{FFT2, IFFT2, FFT2Parallel} {
+		v := f(x)
+		if len(v) != 2 || len(v[0]) != 0 || len(v[1]) != 0 {
+			t.Error("FFT2 error\ninput:", x, "\noutput:", v)
+		}
+	}
+
+	dst := [][]complex128{{}, {}}
+	FFT2Into(dst, x)
+}
+
 func TestFFTN(t *testing.T) {
 	for _, ft := range fftnTests {
 		m := dsputils.MakeMatrix(dsputils.ToComplex(ft.in), ft.dim)
@@ -272,6 +287,31 @@ func BenchmarkFFT(b *testing.B) {
 	}
 }
 
+// BenchmarkFFTSizes benchmarks power of 2 sizes from 2^6 to 2^20 on a single
+// worker, for reproducible comparisons between machines and implementations.
+// run with: go test -test.bench="FFTSizes"
+func BenchmarkFFTSizes(b *testing.B) {
+	for s := 6; s <= 20; s++ {
+		N := 1 << s
+		b.Run(fmt.Sprintf("%d", N), func(b *testing.B) {
+			SetWorkerPoolSize(1)
+			SetBackend(nil)
+			defer SetWorkerPoolSize(0)
+
+			a := make([]complex128, N)
+			for i := 0; i < N; i++ {
+				a[i] = complex(float64(i)/float64(N), 0)
+			}
+
+			EnsureRadix2Factors(N)
+
+			for b.Loop() {
+				FFT(a)
+			}
+		})
+	}
+}
+
 // This example is adapted from Richard Lyon's "Understanding Digital Signal Processing," section 3.1.1.
 func ExampleFFTReal() {
 	numSamples := 8
@@ -311,3 +351,66 @@ func ExampleFFTReal() {
 	// X(6) = 2.0 ∠ -45.0°
 	// X(7) = 4.0 ∠ 90.0°
 }
+
+func TestConvolveE(t *testing.T) {
+	x := []complex128{1, 2, 3, 4}
+	if r, err := ConvolveE(x, x[:3]); !errors.Is(err, dsputils.ErrLengthMismatch) || r != nil {
+		t.Error("ConvolveE error\noutput:", r, err, "\nexpected:", dsputils.ErrLengthMismatch)
+	}
+
+	r, err := ConvolveE(x, x)
+	if e := Convolve(x, x); err != nil || !dsputils.PrettyCloseC(r, e) {
+		t.Error("ConvolveE error\noutput:", r, err, "\nexpected:", e)
+	}
+}
+
+func TestFFTConcurrent(t *testing.T) {
+	sizes := []int{8, 12, 64, 100, 256, 1000}
+	inputs := make([][]complex128, len(sizes))
+	expected := make([][]complex128, len(sizes))
+	for i, n := range sizes {
+		inputs[i] = randomMatrix(1, n, int64(n))[0]
+		expected[i] = FFT(inputs[i])
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 64)
+	for g := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range 20 {
+				i := (g + k) % len(sizes)
+				if g == 0 && k%5 == 0 {
+					ClearPlanCache()
+				}
+				if v := FFT(inputs[i]); !dsputils.PrettyCloseC(v, expected[i]) {
+					errs <- fmt.Sprint("size ", sizes[i])
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for e := range errs {
+		t.Error("FFT concurrent error\n", e)
+	}
+}
+
+func TestIFFTArbitraryLength(t *testing.T) {
+	for _, n := range []int{3, 100, 1000, 1013} {
+		x := randomMatrix(1, n, int64(n))[0]
+		if v := IFFT(FFT(x)); !dsputils.PrettyCloseC(v, x) {
+			t.Error("IFFT round trip error\nlength:", n, "\noutput:", v, "\nexpected:", x)
+		}
+		if v, e := IFFT(x), IDFT(x); !dsputils.PrettyCloseC(v, e) {
+			t.Error("IFFT error\nlength:", n, "\noutput:", v, "\nexpected:", e)
+		}
+	}
+
+	if v := IFFT(nil); len(v) != 0 {
+		t.Error("IFFT error\noutput:", v, "\nexpected: []")
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"runtime"
+	"sync"
+)
+
+// Hilbert returns the analytic signal of the real-valued x, like SciPy's
+// signal.hilbert: x plus j times its Hilbert transform, computed by zeroing
+// the negative frequencies of the FFT of x and doubling the positive ones.
+// The real part of the result is x, and its magnitude is the envelope of x.
+func Hilbert(x []float64) []complex128 {
+	n := len(x)
+	if n == 0 {
+		return []complex128{}
+	}
+
+	f := FFTReal(x)
+	for k := 1; k < n; k++ {
+		switch {
+		case 2*k < n:
+			f[k] *= 2
+		case 2*k > n:
+			f[k] = 0
+		}
+	}
+
+	return IFFT(f)
+}
+
+// HilbertBatch returns the analytic signal of each frame, as Hilbert does.
+// The frames, which may differ in length, are processed concurrently by the
+// number of workers set by SetWorkerPoolSize; the twiddle factors of each
+// length are computed once and shared by all frames of that length.
+func HilbertBatch(frames [][]float64) [][]complex128 {
+	r := make([][]complex128, len(frames))
+
+	num_workers := worker_pool_size
+	if num_workers == 0 {
+		num_workers = runtime.GOMAXPROCS(0)
+	}
+	num_workers = min(num_workers, len(frames))
+
+	jobs := make(chan int, len(frames))
+	for i := range frames {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg := sync.WaitGroup{}
+	for range num_workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				r[i] = Hilbert(frames[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	return r
+}
+
+// InstantaneousFrequency returns the instantaneous frequency of the
+// real-valued x in Hz, for the sampling frequency fs: the time derivative of
+// the unwrapped phase of the analytic signal of x, divided by 2*Pi. The
+// derivative is a central difference, and a one-sided difference at the first
+// and last samples, like NumPy's gradient. The analytic signal is computed by
+// Hilbert, which treats x as periodic, so the estimate is less accurate near
+// the ends of x.
+func InstantaneousFrequency(x []float64, fs float64) []float64 {
+	n := len(x)
+	r := make([]float64, n)
+	if n < 2 {
+		return r
+	}
+
+	z := Hilbert(x)
+	phase := make([]float64, n)
+	phase[0] = cmplx.Phase(z[0])
+	for i := 1; i < n; i++ {
+		d := cmplx.Phase(z[i]) - cmplx.Phase(z[i-1])
+		phase[i] = phase[i-1] + d - 2*math.Pi*math.Round(d/(2*math.Pi))
+	}
+
+	c := fs / (2 * math.Pi)
+	r[0] = (phase[1] - phase[0]) * c
+	r[n-1] = (phase[n-1] - phase[n-2]) * c
+	for i := 1; i < n-1; i++ {
+		r[i] = (phase[i+1] - phase[i-1]) / 2 * c
+	}
+
+	return r
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestHilbert(t *testing.T) {
+	// the analytic signal of a cosine at a bin frequency is a complex
+	// exponential
+	for _, n := range []int{16, 15} {
+		x := make([]float64, n)
+		e := make([]complex128, n)
+		for i := range x {
+			w := 2 * math.Pi * 3 * float64(i) / float64(n)
+			x[i] = math.Cos(w)
+			e[i] = cmplx.Exp(complex(0, w))
+		}
+		if o := Hilbert(x); !dsputils.PrettyCloseC(o, e) {
+			t.Error("Hilbert error\nlength:", n, "\noutput:", o, "\nexpected:", e)
+		}
+	}
+
+	// scipy.signal.hilbert([1, 2, 3, 4])
+	if o, e := Hilbert([]float64{1, 2, 3, 4}), []complex128{1 + 1i, 2 - 1i, 3 - 1i, 4 + 1i}; !dsputils.PrettyCloseC(o, e) {
+		t.Error("Hilbert error\ninput: [1 2 3 4]\noutput:", o, "\nexpected:", e)
+	}
+	if o := Hilbert(nil); len(o) != 0 {
+		t.Error("Hilbert error\noutput:", o, "\nexpected: []")
+	}
+}
+
+func TestHilbertBatch(t *testing.T) {
+	frames := [][]float64{randomReal(64, 1), randomReal(64, 2), randomReal(100, 3), {}, randomReal(7, 4)}
+	r := HilbertBatch(frames)
+	if len(r) != len(frames) {
+		t.Fatal("HilbertBatch length error\noutput:", len(r), "\nexpected:", len(frames))
+	}
+	for i, f := range frames {
+		if e := Hilbert(f); !dsputils.PrettyCloseC(r[i], e) {
+			t.Error("HilbertBatch error\nframe:", i, "\noutput:", r[i], "\nexpected:", e)
+		}
+	}
+}
+
+func BenchmarkHilbertBatch(b *testing.B) {
+	frames := make([][]float64, 512)
+	for i := range frames {
+		frames[i] = randomReal(1024, int64(i))
+	}
+
+	b.Run("Hilbert", func(b *testing.B) {
+		for b.Loop() {
+			for _, f := range frames {
+				Hilbert(f)
+			}
+		}
+	})
+	b.Run("HilbertBatch", func(b *testing.B) {
+		for b.Loop() {
+			HilbertBatch(frames)
+		}
+	})
+}
+
+func TestInstantaneousFrequency(t *testing.T) {
+	// a linear chirp from 50 to 150 Hz over 1 s
+	const fs, f0, f1 = 1000, 50.0, 150.0
+	x := make([]float64, fs)
+	for i := range x {
+		tm := float64(i) / fs
+		x[i] = math.Cos(2 * math.Pi * (f0*tm + (f1-f0)*tm*tm/2))
+	}
+
+	r := InstantaneousFrequency(x, fs)
+	if len(r) != len(x) {
+		t.Fatal("InstantaneousFrequency length error\noutput:", len(r), "\nexpected:", len(x))
+	}
+	// skip the ends, where the chirp is not periodic
+	for i := fs / 10; i < fs-fs/10; i++ {
+		if e := f0 + (f1-f0)*float64(i)/fs; math.Abs(r[i]-e) > 1 {
+			t.Error("InstantaneousFrequency error\nindex:", i, "\noutput:", r[i], "\nexpected:", e)
+			break
+		}
+	}
+
+	if r := InstantaneousFrequency([]float64{1}, fs); len(r) != 1 || r[0] != 0 {
+		t.Error("InstantaneousFrequency error\noutput:", r, "\nexpected: [0]")
+	}
+}
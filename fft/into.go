@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"sync"
+)
+
+// bufPool holds *[]complex128 scratch buffers for the Into transforms, so
+// that they do not usually allocate once warmed up.
+var bufPool = sync.Pool{
+	New: func() any { return new([]complex128) },
+}
+
+// FFTInto stores the forward FFT of x in dst, like FFT. dst must have the same
+// length as x, and may be x, to transform in place.
+//
+// The Into functions take their scratch buffers from a sync.Pool, so once
+// warmed up with a given size they normally allocate no memory of their own,
+// and do not allocate with a backend that does not. The pool may drop
+// buffers, such as during garbage collection or with the race detector, and
+// then a call allocates new ones.
+//
+// The built-in engine transforms power of 2 lengths with the Radix2
+// algorithm in the scratch buffers, without allocating, when each transform
+// runs on a single worker: with SetWorkerPoolSize(1), or when SetMinChunk is
+// at least half the length. Parallel transforms allocate their workers, and
+// the other algorithms allocate working buffers.
+func FFTInto(dst, x []complex128) {
+	computeFFTInto(dst, x, false)
+}
+
+// IFFTInto stores the inverse FFT of x in dst, like IFFT. The requirements on
+// dst are those of FFTInto.
+func IFFTInto(dst, x []complex128) {
+	computeFFTInto(dst, x, true)
+}
+
+func computeFFTInto(dst, x []complex128, inverse bool) {
+	n := len(x)
+	if len(dst) != n {
+		panic("arrays not of equal size")
+	}
+
+	bp := bufPool.Get().(*[]complex128)
+	if cap(*bp) < 2*n {
+		*bp = make([]complex128, 2*n)
+	}
+	defer bufPool.Put(bp)
+
+	in, out := (*bp)[:n], (*bp)[n:2*n]
+	copy(in, x)
+	transformInto(out, in, inverse)
+	copy(dst, out)
+}
+
+// FFT2Into stores the 2-dimensional, forward FFT of the complex-valued matrix
+// x in dst, like FFT2. dst must have the same shape as x: len(x) rows of
+// len(x[0]) elements. dst may be x, to transform in place. Its allocations
+// are those of FFTInto.
+func FFT2Into(dst, x [][]complex128) {
+	computeFFT2Into(dst, x, false)
+}
+
+// IFFT2Into stores the 2-dimensional, inverse FFT of the complex-valued matrix
+// x in dst, like IFFT2. The requirements on dst are those of FFT2Into.
+func IFFT2Into(dst, x [][]complex128) {
+	computeFFT2Into(dst, x, true)
+}
+
+func computeFFT2Into(dst, x [][]complex128, inverse bool) {
+	rows := len(x)
+	if rows == 0 {
+		panic("empty input array")
+	}
+	cols := len(x[0])
+	if len(dst) != rows {
+		panic("arrays not of equal size")
+	}
+	for i, v := range x {
+		if len(v) != cols {
+			panic("ragged input array")
+		}
+		if len(dst[i]) != cols {
+			panic("arrays not of equal size")
+		}
+	}
+
+	n := max(rows, cols)
+	bp := bufPool.Get().(*[]complex128)
+	if cap(*bp) < 2*n {
+		*bp = make([]complex128, 2*n)
+	}
+	defer bufPool.Put(bp)
+
+	// Transform through separate input and output buffers, since backends
+	// need not support dst and src overlapping.
+	in, out := (*bp)[:cols], (*bp)[n:n+cols]
+	for i, v := range x {
+		copy(in, v)
+		transformInto(out, in, inverse)
+		copy(dst[i], out)
+	}
+
+	in, out = (*bp)[:rows], (*bp)[n:n+rows]
+	for j := range cols {
+		for i := range rows {
+			in[i] = dst[i][j]
+		}
+		transformInto(out, in, inverse)
+		for i, v := range out {
+			dst[i][j] = v
+		}
+	}
+}
+
+// FFTNInto stores the N-dimensional, forward FFT of x in dst, like FFTN. x
+// holds an array of dimensions dims in row-major order, as for FFTAxis. dst
+// must have the same length as x, and may be x, to transform in place. Its
+// allocations are those of FFTInto.
+func FFTNInto(dst, x []complex128, dims []int) {
+	computeFFTNInto(dst, x, dims, false)
+}
+
+// IFFTNInto stores the N-dimensional, inverse FFT of x in dst, like IFFTN.
+// The requirements on dst are those of FFTNInto.
+func IFFTNInto(dst, x []complex128, dims []int) {
+	computeFFTNInto(dst, x, dims, true)
+}
+
+func computeFFTNInto(dst, x []complex128, dims []int, inverse bool) {
+	length, n := 1, 0
+	for _, d := range dims {
+		if d < 1 {
+			panic("invalid dimensions")
+		}
+		length *= d
+		n = max(n, d)
+	}
+	if len(x) != length {
+		panic("data length does not match dimensions")
+	}
+	if len(dst) != length {
+		panic("arrays not of equal size")
+	}
+
+	bp := bufPool.Get().(*[]complex128)
+	if cap(*bp) < 2*n {
+		*bp = make([]complex128, 2*n)
+	}
+	defer bufPool.Put(bp)
+
+	copy(dst, x)
+
+	// transform along each axis in turn; element k of the line at (outer,
+	// inner) is at outer*d*stride + k*stride + inner
+	stride := length
+	for _, d := range dims {
+		stride /= d
+		in, out := (*bp)[:d], (*bp)[n:n+d]
+		for o := 0; o < length; o += d * stride {
+			for i := range stride {
+				for k := range in {
+					in[k] = dst[o+k*stride+i]
+				}
+				transformInto(out, in, inverse)
+				for k, v := range out {
+					dst[o+k*stride+i] = v
+				}
+			}
+		}
+	}
+}
+
+// transformInto stores the forward or inverse FFT of src in dst using the
+// current backend and settings, like FFT and IFFT. src may be modified, and
+// must not overlap dst.
+func transformInto(dst, src []complex128, inverse bool) {
+	if flush_denormals {
+		flushDenormals(src)
+	}
+	b := currentBackend()
+	if _, ok := b.(builtinBackend); ok && len(src) > 1 && algorithmFor(len(src)) == Radix2 {
+		radix2Into(dst, src, inverse)
+	} else if inverse {
+		b.IFFT(dst, src)
+	} else {
+		b.FFT(dst, src)
+	}
+	if flush_denormals {
+		flushDenormals(dst)
+	}
+}
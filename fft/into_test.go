@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// dftBackend computes transforms directly into dst, without allocating.
+type dftBackend struct{}
+
+func (dftBackend) FFT(dst, src []complex128) { dftInto(dst, src, -1) }
+
+func (dftBackend) IFFT(dst, src []complex128) { dftInto(dst, src, 1) }
+
+func dftInto(dst, src []complex128, sign float64) {
+	n := len(src)
+	for k := range dst {
+		var s complex128
+		for j, v := range src {
+			sn, cs := math.Sincos(sign * 2 * math.Pi * float64(k*j%n) / float64(n))
+			s += v * complex(cs, sn)
+		}
+		if sign > 0 {
+			s /= complex(float64(n), 0)
+		}
+		dst[k] = s
+	}
+}
+
+func TestFFTInto(t *testing.T) {
+	for _, n := range []int{1, 8, 15} {
+		x := randomMatrix(1, n, int64(n))[0]
+		dst := make([]complex128, n)
+
+		FFTInto(dst, x)
+		if e := FFT(x); !dsputils.PrettyCloseC(dst, e) {
+			t.Error("FFTInto error\nlength:", n, "\noutput:", dst, "\nexpected:", e)
+		}
+		IFFTInto(dst, dst)
+		if !dsputils.PrettyCloseC(dst, x) {
+			t.Error("IFFTInto error\nlength:", n, "\noutput:", dst, "\nexpected:", x)
+		}
+	}
+
+	SetBackend(dftBackend{})
+	defer SetBackend(nil)
+
+	if raceEnabled {
+		t.Skip("allocations are not reproducible with the race detector")
+	}
+	x := randomMatrix(1, 15, 1)[0]
+	dst := make([]complex128, 15)
+	FFTInto(dst, x) // warm up
+	if n := testing.AllocsPerRun(10, func() { FFTInto(dst, x) }); n != 0 {
+		t.Error("FFTInto allocation error\noutput:", n, "\nexpected:", 0)
+	}
+}
+
+func TestFFTIntoBuiltinAllocs(t *testing.T) {
+	SetWorkerPoolSize(1)
+	defer SetWorkerPoolSize(0)
+
+	x := randomMatrix(1, 64, 1)[0]
+	dst := make([]complex128, 64)
+	FFTInto(dst, x) // warm up
+
+	// the results are identical to FFT and IFFT
+	e := FFT(x)
+	for i := range e {
+		if dst[i] != e[i] {
+			t.Error("FFTInto error\nindex:", i, "\noutput:", dst[i], "\nexpected:", e[i])
+			break
+		}
+	}
+	IFFTInto(dst, x)
+	e = IFFT(x)
+	for i := range e {
+		if dst[i] != e[i] {
+			t.Error("IFFTInto error\nindex:", i, "\noutput:", dst[i], "\nexpected:", e[i])
+			break
+		}
+	}
+
+	if raceEnabled {
+		t.Skip("allocations are not reproducible with the race detector")
+	}
+	if n := testing.AllocsPerRun(10, func() { FFTInto(dst, x) }); n != 0 {
+		t.Error("FFTInto allocation error\noutput:", n, "\nexpected:", 0)
+	}
+	if n := testing.AllocsPerRun(10, func() { IFFTInto(dst, dst) }); n != 0 {
+		t.Error("IFFTInto allocation error\noutput:", n, "\nexpected:", 0)
+	}
+
+	m := randomMatrix(2, 64, 2)
+	md := randomMatrix(2, 64, 3)
+	FFT2Into(md, m)
+	if n := testing.AllocsPerRun(10, func() { FFT2Into(md, m) }); n != 0 {
+		t.Error("FFT2Into allocation error\noutput:", n, "\nexpected:", 0)
+	}
+
+	dims := []int{4, 2, 8}
+	FFTNInto(dst, x, dims)
+	if n := testing.AllocsPerRun(10, func() { FFTNInto(dst, x, dims) }); n != 0 {
+		t.Error("FFTNInto allocation error\noutput:", n, "\nexpected:", 0)
+	}
+}
+
+func TestFFT2Into(t *testing.T) {
+	for _, sh := range []struct{ rows, cols int }{{1, 1}, {4, 8}, {5, 3}, {16, 16}} {
+		x := randomMatrix(sh.rows, sh.cols, int64(sh.rows*sh.cols))
+		dst := randomMatrix(sh.rows, sh.cols, 0)
+
+		FFT2Into(dst, x)
+		e := FFT2(x)
+		for i := range e {
+			if !dsputils.PrettyCloseC(dst[i], e[i]) {
+				t.Error("FFT2Into error\nshape:", sh, "\nrow:", i, "\noutput:", dst[i], "\nexpected:", e[i])
+			}
+		}
+
+		// in place
+		IFFT2Into(dst, dst)
+		for i := range x {
+			if !dsputils.PrettyCloseC(dst[i], x[i]) {
+				t.Error("IFFT2Into error\nshape:", sh, "\nrow:", i, "\noutput:", dst[i], "\nexpected:", x[i])
+			}
+		}
+	}
+}
+
+func TestFFTNInto(t *testing.T) {
+	for _, dims := range [][]int{{1}, {6}, {2, 3, 4}, {4, 8}, {2, 1, 8, 3}} {
+		length := 1
+		for _, d := range dims {
+			length *= d
+		}
+		x := randomMatrix(1, length, int64(length))[0]
+		dst := make([]complex128, length)
+
+		FFTNInto(dst, x, dims)
+		e := FFTN(dsputils.MakeMatrix(x, dims))
+		if v := dsputils.MakeMatrix(dst, dims); !v.PrettyClose(e) {
+			t.Error("FFTNInto error\ndims:", dims, "\noutput:", v, "\nexpected:", e)
+		}
+
+		// in place
+		IFFTNInto(dst, dst, dims)
+		if !dsputils.PrettyCloseC(dst, x) {
+			t.Error("IFFTNInto error\ndims:", dims, "\noutput:", dst, "\nexpected:", x)
+		}
+	}
+}
+
+func TestFFT2IntoAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not reproducible with the race detector")
+	}
+	SetBackend(dftBackend{})
+	defer SetBackend(nil)
+
+	x := randomMatrix(8, 12, 1)
+	dst := randomMatrix(8, 12, 2)
+	FFT2Into(dst, x) // warm up
+
+	if n := testing.AllocsPerRun(10, func() { FFT2Into(dst, x) }); n != 0 {
+		t.Error("FFT2Into allocation error\noutput:", n, "\nexpected:", 0)
+	}
+	if n := testing.AllocsPerRun(10, func() { IFFT2Into(dst, x) }); n != 0 {
+		t.Error("IFFT2Into allocation error\noutput:", n, "\nexpected:", 0)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// MatchedFilter returns the cross-correlation of signal with template at each
+// offset where template fits entirely within signal, computed via the FFT,
+// and the offset at which it is largest. The output has
+// len(signal)-len(template)+1 elements; output[i] is the dot product of
+// template and signal[i:i+len(template)].
+func MatchedFilter(signal, template []float64) (peakIndex int, output []float64) {
+	output = correlateValid(signal, template)
+	return argmax(output), output
+}
+
+// MatchedFilterNormalized is like MatchedFilter, but divides each output by
+// the norms of template and the segment of signal it is compared with. The
+// output is between -1 and 1, and is 1 where the segment is a positive
+// multiple of template, so the peak value indicates detection confidence.
+// Segments of zero energy have output 0.
+func MatchedFilterNormalized(signal, template []float64) (peakIndex int, output []float64) {
+	output = correlateValid(signal, template)
+
+	var te float64
+	for _, v := range template {
+		te += v * v
+	}
+
+	// running energy of the signal segments
+	var se float64
+	for _, v := range signal[:len(template)] {
+		se += v * v
+	}
+	for i := range output {
+		if i > 0 {
+			a, b := signal[i-1], signal[i+len(template)-1]
+			se += b*b - a*a
+		}
+		if d := math.Sqrt(max(se, 0) * te); d > 1e-12*te {
+			output[i] /= d
+		} else {
+			output[i] = 0
+		}
+	}
+
+	return argmax(output), output
+}
+
+// correlateValid returns the valid part of the cross-correlation of x with y.
+func correlateValid(x, y []float64) []float64 {
+	if len(y) == 0 {
+		panic("empty template")
+	}
+	if len(y) > len(x) {
+		panic("template longer than signal")
+	}
+
+	n := dsputils.NextPowerOf2(len(x) + len(y) - 1)
+	xf := FFT(dsputils.ZeroPad(dsputils.ToComplex(x), n))
+	yf := FFT(dsputils.ZeroPad(dsputils.ToComplex(y), n))
+	for i, v := range yf {
+		xf[i] *= complex(real(v), -imag(v))
+	}
+
+	c := IFFT(xf)
+	r := make([]float64, len(x)-len(y)+1)
+	for i := range r {
+		r[i] = real(c[i])
+	}
+	return r
+}
+
+func argmax(x []float64) int {
+	m := 0
+	for i, v := range x {
+		if v > x[m] {
+			m = i
+		}
+	}
+	return m
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMatchedFilter(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// a linear chirp
+	template := make([]float64, 64)
+	for i := range template {
+		ti := float64(i)
+		template[i] = math.Sin(0.05*ti + 0.004*ti*ti)
+	}
+
+	for _, offset := range []int{0, 100, 1000, 2048 - 64} {
+		signal := make([]float64, 2048)
+		for i := range signal {
+			signal[i] = 0.5 * r.NormFloat64()
+		}
+		for i, v := range template {
+			signal[offset+i] += 2 * v
+		}
+
+		peak, out := MatchedFilter(signal, template)
+		if len(out) != len(signal)-len(template)+1 {
+			t.Error("MatchedFilter length error\noutput:", len(out), "\nexpected:", len(signal)-len(template)+1)
+		}
+		if peak != offset {
+			t.Error("MatchedFilter error\noffset:", offset, "\noutput:", peak, "\nexpected:", offset)
+		}
+
+		// compare one output with the direct dot product
+		var e float64
+		for i, v := range template {
+			e += v * signal[offset+i]
+		}
+		if math.Abs(out[offset]-e) > 1e-9 {
+			t.Error("MatchedFilter value error\noutput:", out[offset], "\nexpected:", e)
+		}
+
+		peak, out = MatchedFilterNormalized(signal, template)
+		if peak != offset || out[peak] < 0.8 || out[peak] > 1 {
+			t.Error("MatchedFilterNormalized error\noffset:", offset, "\noutput:", peak, out[peak], "\nexpected:", offset)
+		}
+	}
+
+	// an exact copy has confidence 1
+	_, out := MatchedFilterNormalized(append(make([]float64, 10), template...), template)
+	if math.Abs(out[10]-1) > 1e-9 {
+		t.Error("MatchedFilterNormalized exact error\noutput:", out[10], "\nexpected:", 1)
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+)
+
+// NormMode specifies how the forward and inverse FFTs are scaled. The names
+// follow NumPy's norm argument and refer to the direction that is scaled.
+type NormMode int
+
+const (
+	// Backward scales the inverse FFT by 1/N and leaves the forward FFT
+	// unscaled. This is the convention of FFT and IFFT.
+	Backward NormMode = iota
+	// Ortho scales both directions by 1/sqrt(N), making the transform
+	// unitary. This is Mathematica's default.
+	Ortho
+	// Forward scales the forward FFT by 1/N and leaves the inverse FFT
+	// unscaled.
+	Forward
+)
+
+// FFTNorm returns the forward FFT of x, scaled according to mode.
+func FFTNorm(x []complex128, mode NormMode) []complex128 {
+	r := FFT(x)
+	switch mode {
+	case Backward:
+	case Ortho:
+		scale(r, 1/math.Sqrt(float64(len(r))))
+	case Forward:
+		scale(r, 1/float64(len(r)))
+	default:
+		panic("unknown norm mode")
+	}
+	return r
+}
+
+// IFFTNorm returns the inverse FFT of x, scaled according to mode. It inverts
+// FFTNorm with the same mode.
+func IFFTNorm(x []complex128, mode NormMode) []complex128 {
+	r := IFFT(x)
+	switch mode {
+	case Backward:
+	case Ortho:
+		scale(r, math.Sqrt(float64(len(r))))
+	case Forward:
+		scale(r, float64(len(r)))
+	default:
+		panic("unknown norm mode")
+	}
+	return r
+}
+
+func scale(x []complex128, s float64) {
+	c := complex(s, 0)
+	for i := range x {
+		x[i] *= c
+	}
+}
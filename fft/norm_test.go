@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestFFTNorm(t *testing.T) {
+	x := []complex128{1, 2i, -3, 4 + 1i, 0.5, -1i, 2, 3}
+	ref := FFT(x)
+
+	var energy float64
+	for _, v := range x {
+		energy += real(v * cmplx.Conj(v))
+	}
+
+	for _, tt := range []struct {
+		mode  NormMode
+		scale float64
+	}{
+		{Backward, 1},
+		{Ortho, 1 / math.Sqrt(8)},
+		{Forward, 1.0 / 8},
+	} {
+		f := FFTNorm(x, tt.mode)
+		e := make([]complex128, len(ref))
+		for i, v := range ref {
+			e[i] = v * complex(tt.scale, 0)
+		}
+		if !dsputils.PrettyCloseC(f, e) {
+			t.Error("FFTNorm error\nmode:", tt.mode, "\noutput:", f, "\nexpected:", e)
+		}
+
+		if r := IFFTNorm(f, tt.mode); !dsputils.PrettyCloseC(r, x) {
+			t.Error("IFFTNorm round trip error\nmode:", tt.mode, "\noutput:", r, "\nexpected:", x)
+		}
+
+		// the orthonormal transform preserves energy
+		if tt.mode == Ortho {
+			var fe float64
+			for _, v := range f {
+				fe += real(v * cmplx.Conj(v))
+			}
+			if !dsputils.PrettyClose([]float64{fe}, []float64{energy}) {
+				t.Error("FFTNorm energy error\noutput:", fe, "\nexpected:", energy)
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"runtime"
+	"sync"
+)
+
+// FFT2Parallel returns the 2-dimensional, forward FFT of the complex-valued
+// matrix, like FFT2. The rows are transformed concurrently, then the result is
+// transposed so the columns are also transformed concurrently as contiguous
+// rows. The number of concurrent transforms is set by SetWorkerPoolSize.
+func FFT2Parallel(x [][]complex128) [][]complex128 {
+	return computeFFT2(x, FFT, true)
+}
+
+// transformRows replaces each n-element row of data with its transform by
+// fftFunc. If parallel is true, the rows are transformed concurrently.
+func transformRows(data []complex128, n int, fftFunc func([]complex128) []complex128, parallel bool) {
+	rows := len(data) / n
+	if !parallel {
+		for i := range rows {
+			copy(data[i*n:], fftFunc(data[i*n:(i+1)*n]))
+		}
+		return
+	}
+
+	num_workers := worker_pool_size
+	if num_workers == 0 {
+		num_workers = runtime.GOMAXPROCS(0)
+	}
+	num_workers = min(num_workers, rows)
+
+	jobs := make(chan int, rows)
+	for i := range rows {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg := sync.WaitGroup{}
+	for range num_workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				copy(data[i*n:], fftFunc(data[i*n:(i+1)*n]))
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// randomMatrix returns a rows x cols matrix of random complex values.
+func randomMatrix(rows, cols int, seed int64) [][]complex128 {
+	r := rand.New(rand.NewSource(seed))
+	x := make([][]complex128, rows)
+	for i := range x {
+		x[i] = make([]complex128, cols)
+		for j := range x[i] {
+			x[i][j] = complex(r.NormFloat64(), r.NormFloat64())
+		}
+	}
+	return x
+}
+
+func TestFFT2Parallel(t *testing.T) {
+	for _, d := range [][2]int{{1, 1}, {1, 8}, {8, 1}, {16, 16}, {12, 20}, {33, 7}} {
+		x := randomMatrix(d[0], d[1], 1)
+		v := FFT2Parallel(x)
+		e := FFT2(x)
+		for i := range e {
+			if !dsputils.PrettyCloseC(v[i], e[i]) {
+				t.Error("FFT2Parallel error\ndims:", d, "\nrow:", i, "\noutput:", v[i], "\nexpected:", e[i])
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkFFT2Parallel(b *testing.B) {
+	x := randomMatrix(2048, 2048, 1)
+	EnsureRadix2Factors(2048)
+
+	for _, f := range []struct {
+		name string
+		fn   func([][]complex128) [][]complex128
+	}{
+		{"FFT2", FFT2},
+		{"FFT2Parallel", FFT2Parallel},
+	} {
+		b.Run(fmt.Sprintf("%s-2048x2048", f.name), func(b *testing.B) {
+			for b.Loop() {
+				f.fn(x)
+			}
+		})
+	}
+}
+
+func TestSetMinChunk(t *testing.T) {
+	SetWorkerPoolSize(8)
+	defer SetWorkerPoolSize(0)
+	defer SetMinChunk(1)
+
+	x := randomMatrix(1, 256, 1)[0]
+	e := FFT(x)
+
+	for _, tt := range []struct{ chunk, workers int }{{1, 8}, {16, 8}, {64, 2}, {1000, 1}} {
+		SetMinChunk(tt.chunk)
+
+		v := FFT(x)
+		if n, _ := radix2Workers(len(x)); n != tt.workers {
+			t.Error("SetMinChunk workers error\nchunk:", tt.chunk, "\noutput:", n, "\nexpected:", tt.workers)
+		}
+		for i := range v {
+			if v[i] != e[i] {
+				t.Error("SetMinChunk result error\nchunk:", tt.chunk, "\nindex:", i, "\noutput:", v[i], "\nexpected:", e[i])
+				break
+			}
+		}
+	}
+}
+
+func TestParallelDeterministic(t *testing.T) {
+	defer SetWorkerPoolSize(0)
+
+	x := randomMatrix(1, 4096, 1)[0]
+	m := randomMatrix(16, 64, 2)
+	SetWorkerPoolSize(1)
+	e, e2 := FFT(x), FFT2(m)
+
+	for _, n := range []int{0, 2, 8} {
+		SetWorkerPoolSize(n)
+		for range 20 {
+			v, v2 := FFT(x), FFT2Parallel(m)
+			for i := range v {
+				if v[i] != e[i] {
+					t.Fatal("parallel FFT not bit-identical\nworkers:", n, "\nindex:", i, "\noutput:", v[i], "\nexpected:", e[i])
+				}
+			}
+			for i := range v2 {
+				for j := range v2[i] {
+					if v2[i][j] != e2[i][j] {
+						t.Fatal("FFT2Parallel not bit-identical\nworkers:", n, "\nindex:", i, j, "\noutput:", v2[i][j], "\nexpected:", e2[i][j])
+					}
+				}
+			}
+		}
+	}
+}
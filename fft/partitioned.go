@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// PartitionedConvolver convolves a stream with a fixed real-valued kernel
+// using non-uniformly partitioned convolution, for long kernels such as
+// reverb impulse responses where overlap-add with one large block would add
+// too much latency.
+//
+// The kernel is split into partitions that double in size: the first
+// blockSize taps are convolved directly in the time domain, and the
+// partition at offset L (for L = blockSize, 2*blockSize, 4*blockSize, ...)
+// covers the next L taps and is convolved with FFTs of its own length. Each
+// partition is far enough into the kernel that its result is ready before it
+// is needed, so the latency is set by the smallest partition while most of
+// the work is done with large, efficient FFTs.
+type PartitionedConvolver struct {
+	block int
+	head  []float64
+	parts []*partition
+
+	in      []float64 // input of the current block
+	acc     []float64 // pending output, starting at the current block
+	pending []float64 // output not yet returned
+}
+
+// partition is an FFT-convolved segment of the kernel of a
+// PartitionedConvolver.
+type partition struct {
+	offset, size int
+	nfft         int
+	hf           []complex128
+	buf          []float64 // input collected for the next segment
+}
+
+// NewPartitionedConvolver returns a PartitionedConvolver for kernel whose
+// smallest partition is blockSize samples, which is also its latency.
+func NewPartitionedConvolver(kernel []float64, blockSize int) *PartitionedConvolver {
+	if len(kernel) == 0 {
+		panic("empty kernel")
+	}
+	if blockSize < 1 {
+		panic("invalid block size")
+	}
+
+	c := &PartitionedConvolver{
+		block:   blockSize,
+		head:    kernel[:min(blockSize, len(kernel))],
+		pending: make([]float64, blockSize),
+	}
+
+	accLen := blockSize + len(c.head) - 1
+	for l := blockSize; l < len(kernel); l *= 2 {
+		p := &partition{
+			offset: l,
+			size:   l,
+			nfft:   dsputils.NextPowerOf2(2*l - 1),
+		}
+		h := dsputils.ZeroPadF(kernel[l:min(2*l, len(kernel))], p.nfft)
+		p.hf = RFFT(h)
+		c.parts = append(c.parts, p)
+
+		// see processBlock for where the segment result is added
+		accLen = max(accLen, p.offset+blockSize-p.size+2*p.size-1)
+	}
+	c.acc = make([]float64, accLen)
+
+	return c
+}
+
+// Latency returns the number of samples by which the output of c lags the
+// convolution of its input with the kernel. It is the size of the smallest
+// partition.
+func (c *PartitionedConvolver) Latency() int {
+	return c.block
+}
+
+// Process returns the next len(in) samples of the convolution of the stream
+// with the kernel, delayed by Latency samples. The total output of successive
+// calls is independent of how the stream is split into blocks.
+func (c *PartitionedConvolver) Process(in []float64) []float64 {
+	for len(in) > 0 {
+		n := min(c.block-len(c.in), len(in))
+		c.in = append(c.in, in[:n]...)
+		in = in[n:]
+		if len(c.in) == c.block {
+			c.processBlock()
+			c.in = c.in[:0]
+		}
+	}
+
+	n := len(c.pending) - c.block + len(c.in)
+	out := make([]float64, n)
+	copy(out, c.pending)
+	c.pending = append(c.pending[:0], c.pending[n:]...)
+	return out
+}
+
+// processBlock adds the contributions of the complete input block c.in to
+// the pending output, and moves the output for the block to c.pending.
+func (c *PartitionedConvolver) processBlock() {
+	for i, x := range c.in {
+		for j, h := range c.head {
+			c.acc[i+j] += x * h
+		}
+	}
+
+	for _, p := range c.parts {
+		p.buf = append(p.buf, c.in...)
+		if len(p.buf) < p.size {
+			continue
+		}
+
+		xf := RFFT(dsputils.ZeroPadF(p.buf, p.nfft))
+		for i := range xf {
+			xf[i] *= p.hf[i]
+		}
+		y := IRFFT(xf, p.nfft)
+
+		// the segment started size-block samples before the current block
+		start := p.offset + c.block - p.size
+		for i, v := range y[:2*p.size-1] {
+			c.acc[start+i] += v
+		}
+		p.buf = p.buf[:0]
+	}
+
+	c.pending = append(c.pending, c.acc[:c.block]...)
+	copy(c.acc, c.acc[c.block:])
+	clear(c.acc[len(c.acc)-c.block:])
+}
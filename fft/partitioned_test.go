@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPartitionedConvolver(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, tt := range []struct {
+		klen, block int
+	}{
+		{1, 1},
+		{5, 1},
+		{5, 8},
+		{100, 4},
+		{1000, 16},
+		{3000, 64},
+		{777, 5},
+	} {
+		kernel := randomReal(tt.klen, int64(tt.klen))
+		x := randomReal(2000, 2)
+		e := directConvolve(x, kernel)
+
+		c := NewPartitionedConvolver(kernel, tt.block)
+		if c.Latency() != tt.block {
+			t.Error("PartitionedConvolver latency error\noutput:", c.Latency(), "\nexpected:", tt.block)
+		}
+
+		// feed the signal, then enough zeros to flush it, in random chunks
+		in := append(append([]float64(nil), x...), make([]float64, tt.klen+tt.block)...)
+		var out []float64
+		for len(in) > 0 {
+			n := min(rnd.Intn(3*tt.block)+1, len(in))
+			y := c.Process(in[:n])
+			if len(y) != n {
+				t.Error("PartitionedConvolver length error\noutput:", len(y), "\nexpected:", n)
+			}
+			out = append(out, y...)
+			in = in[n:]
+		}
+
+		for i, v := range out[:tt.block] {
+			if v != 0 {
+				t.Error("PartitionedConvolver latency error\nindex:", i, "\noutput:", v, "\nexpected:", 0)
+				break
+			}
+		}
+		for i, v := range out[tt.block : tt.block+len(e)] {
+			if math.Abs(v-e[i]) > 1e-9 {
+				t.Error("PartitionedConvolver error\nkernel:", tt.klen, "\nblock:", tt.block, "\nindex:", i, "\noutput:", v, "\nexpected:", e[i])
+				break
+			}
+		}
+	}
+}
+
+func TestPartitionedConvolverLatency(t *testing.T) {
+	// an impulse first appears at the output Latency samples later
+	kernel := randomReal(500, 1)
+	c := NewPartitionedConvolver(kernel, 32)
+
+	x := make([]float64, 600)
+	x[0] = 1
+	y := c.Process(x)
+
+	first := -1
+	for i, v := range y {
+		if v != 0 {
+			first = i
+			break
+		}
+	}
+	if first != c.Latency() {
+		t.Error("PartitionedConvolver latency error\noutput:", first, "\nexpected:", c.Latency())
+	}
+}
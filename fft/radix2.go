@@ -20,6 +20,8 @@ import (
 	"math"
 	"runtime"
 	"sync"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
 )
 
 var (
@@ -57,9 +59,20 @@ func getRadix2Factors(input_len int) []complex128 {
 					radix2Factors[i][n] = radix2Factors[p][j]
 				}
 
-				for n := 1; n < i; n += 2 {
-					sin, cos := math.Sincos(-2 * math.Pi / float64(i) * float64(n))
-					radix2Factors[i][n] = complex(cos, sin)
+				if twiddle_mode == Fast {
+					// w^n for odd n by repeated multiplication by w^2
+					sin, cos := math.Sincos(-2 * math.Pi / float64(i))
+					w := complex(cos, sin)
+					w2 := w * w
+					for n := 1; n < i; n += 2 {
+						radix2Factors[i][n] = w
+						w *= w2
+					}
+				} else {
+					for n := 1; n < i; n += 2 {
+						sin, cos := math.Sincos(-2 * math.Pi / float64(i) * float64(n))
+						radix2Factors[i][n] = complex(cos, sin)
+					}
 				}
 			}
 		}
@@ -68,57 +81,135 @@ func getRadix2Factors(input_len int) []complex128 {
 	return radix2Factors[input_len]
 }
 
+// TwiddleMode specifies how the twiddle factors of radix-2 FFTs are computed.
+type TwiddleMode int
+
+const (
+	// Accurate computes each twiddle factor directly with math.Sincos.
+	Accurate TwiddleMode = iota
+	// Fast computes twiddle factors by repeated complex multiplication, which
+	// is cheaper but accumulates rounding error that grows with the FFT
+	// length, raising the noise floor of large FFTs.
+	Fast
+)
+
+var (
+	twiddle_mode = Accurate
+)
+
+// SetTwiddleMode sets how twiddle factors are computed for radix-2 FFTs. The
+// default is Accurate. Factors are computed once per length and cached, so
+// the mode mostly affects the first transform of each length; changing it
+// clears the cache (see ClearPlanCache).
+func SetTwiddleMode(mode TwiddleMode) {
+	if mode != Accurate && mode != Fast {
+		panic("unknown twiddle mode")
+	}
+
+	radix2Lock.Lock()
+	twiddle_mode = mode
+	radix2Lock.Unlock()
+
+	ClearPlanCache()
+}
+
 func hasRadix2Factors(idx int) bool {
 	return radix2Factors[idx] != nil
 }
 
 type fft_work struct {
-	start, end int
+	start, end, stage int
 }
 
 // radix2FFT returns the FFT calculated using the radix-2 DIT Cooley-Tukey algorithm.
 func radix2FFT(x []complex128) []complex128 {
-	lx := len(x)
-	factors := getRadix2Factors(lx)
+	return radix2Transform(reorderData(x), false)
+}
 
-	t := make([]complex128, lx) // temp
-	r := reorderData(x)
+// radix2IFFT returns the inverse FFT calculated with the same butterfly
+// schedule as radix2FFT, but with conjugated (positive-exponent) twiddle
+// factors, so it needs no passes to reverse or conjugate the data.
+func radix2IFFT(x []complex128) []complex128 {
+	r := radix2Transform(reorderData(x), true)
 
-	var blocks, stage, s_2 int
+	N := complex(float64(len(r)), 0)
+	for n := range r {
+		r[n] /= N
+	}
+	return r
+}
 
-	jobs := make(chan *fft_work, lx)
-	wg := sync.WaitGroup{}
+// radix2Into stores the radix-2 FFT of src, or the inverse FFT if inverse is
+// true, in dst without allocating when it runs on a single worker. src is
+// overwritten and must not overlap dst.
+func radix2Into(dst, src []complex128, inverse bool) {
+	reorderInPlace(src)
+	r := radix2Stages(src, dst, inverse)
+	if &r[0] != &dst[0] {
+		copy(dst, r)
+	}
+
+	if inverse {
+		N := complex(float64(len(dst)), 0)
+		for n := range dst {
+			dst[n] /= N
+		}
+	}
+}
+
+// radix2Transform returns the unscaled radix-2 DFT with negative exponents,
+// or positive exponents if inverse is true, of the data r in bit-reversed
+// order, as returned by reorderData. r is overwritten.
+func radix2Transform(r []complex128, inverse bool) []complex128 {
+	return radix2Stages(r, make([]complex128, len(r)), inverse)
+}
+
+// radix2Stages is like radix2Transform, but uses t, of the same length as r,
+// as its working buffer. The result is in whichever of r and t is returned.
+// The stages are those of StageParams(len(r)). They run on the calling
+// goroutine if there is a single worker, and otherwise on a pool of workers.
+func radix2Stages(r, t []complex128, inverse bool) []complex128 {
+	lx := len(r)
+	factors := getRadix2Factors(lx)
+
+	num_workers, idx_diff := radix2Workers(lx)
+	if num_workers == 1 {
+		for stage := 2; stage <= lx; stage <<= 1 {
+			radix2Butterflies(t, r, factors, 0, lx, stage, inverse)
+			r, t = t, r
+		}
+		return r
+	}
 
-	num_workers := worker_pool_size
+	return radix2Parallel(r, t, factors, inverse, num_workers, idx_diff)
+}
+
+// radix2Workers returns the number of workers used by radix2Stages for a
+// transform of length lx, and the minimum number of elements in each job.
+func radix2Workers(lx int) (num_workers, idx_diff int) {
+	num_workers = worker_pool_size
 	if (num_workers) == 0 {
 		num_workers = runtime.GOMAXPROCS(0)
 	}
 
-	idx_diff := lx / num_workers
-	if idx_diff < 2 {
-		idx_diff = 2
-	}
+	// each job has at least min_chunk butterflies, and there are no more
+	// workers than jobs
+	idx_diff = max(lx/num_workers, 2*min_chunk)
+	num_workers = min(num_workers, max(lx/idx_diff, 1))
+	return num_workers, idx_diff
+}
 
+// radix2Parallel runs the stages of radix2Stages on num_workers goroutines,
+// in jobs of at least idx_diff elements.
+func radix2Parallel(r, t, factors []complex128, inverse bool, num_workers, idx_diff int) []complex128 {
+	lx := len(r)
+	jobs := make(chan fft_work, lx)
+	wg := sync.WaitGroup{}
+
+	var src, dst []complex128
 	worker := func() {
 		for work := range jobs {
-			for nb := work.start; nb < work.end; nb += stage {
-				if stage != 2 {
-					for j := 0; j < s_2; j++ {
-						idx := j + nb
-						idx2 := idx + s_2
-						ridx := r[idx]
-						w_n := r[idx2] * factors[blocks*j]
-						t[idx] = ridx + w_n
-						t[idx2] = ridx - w_n
-					}
-				} else {
-					n1 := nb + 1
-					rn := r[nb]
-					rn1 := r[n1]
-					t[nb] = rn + rn1
-					t[n1] = rn - rn1
-				}
-			}
+			radix2Butterflies(dst, src, factors, work.start, work.end, work.stage, inverse)
 			wg.Done()
 		}
 	}
@@ -128,14 +219,13 @@ func radix2FFT(x []complex128) []complex128 {
 	}
 	defer close(jobs)
 
-	for stage = 2; stage <= lx; stage <<= 1 {
-		blocks = lx / stage
-		s_2 = stage / 2
+	for stage := 2; stage <= lx; stage <<= 1 {
+		src, dst = r, t
 
 		for start, end := 0, stage; ; {
 			if end-start >= idx_diff || end == lx {
 				wg.Add(1)
-				jobs <- &fft_work{start, end}
+				jobs <- fft_work{start, end, stage}
 
 				if end == lx {
 					break
@@ -153,20 +243,106 @@ func radix2FFT(x []complex128) []complex128 {
 	return r
 }
 
+// radix2Butterflies stores in t the butterflies of size stage of r, for the
+// blocks starting in [start, end).
+func radix2Butterflies(t, r, factors []complex128, start, end, stage int, inverse bool) {
+	s_2 := stage / 2
+	blocks := len(r) / stage
+
+	for nb := start; nb < end; nb += stage {
+		if stage != 2 {
+			for j := 0; j < s_2; j++ {
+				idx := j + nb
+				idx2 := idx + s_2
+				ridx := r[idx]
+				w := factors[blocks*j]
+				if inverse {
+					w = complex(real(w), -imag(w))
+				}
+				w_n := r[idx2] * w
+				t[idx] = ridx + w_n
+				t[idx2] = ridx - w_n
+			}
+		} else {
+			n1 := nb + 1
+			rn := r[nb]
+			rn1 := r[n1]
+			t[nb] = rn + rn1
+			t[n1] = rn - rn1
+		}
+	}
+}
+
+// Stage describes one stage of the radix-2 FFT butterfly schedule.
+//
+// The input is first permuted into bit-reversed order: element i moves to the
+// index whose log2(n) bits are those of i reversed. In each stage, for every
+// block start nb that is a multiple of Size and every j in [0, Half), the pair
+// at nb+j and nb+j+Half is replaced by
+//
+//	a + w*b, a - w*b
+//
+// where a and b are the pair's values and w is the twiddle factor
+// exp(-2πi*Blocks*j/n).
+type Stage struct {
+	// Size is the length of the sub-transforms combined by this stage.
+	Size int
+	// Half is Size/2, the distance between the elements of a butterfly.
+	Half int
+	// Blocks is n/Size, the number of sub-transforms, which is also the
+	// stride through the n-point twiddle factors.
+	Blocks int
+}
+
+// StageParams returns the butterfly schedule used by the radix-2 FFT of
+// length n, which must be a power of 2. The stages are in order of
+// application, so their Size is 2, 4, ..., n.
+func StageParams(n int) []Stage {
+	if n < 1 || !dsputils.IsPowerOf2(n) {
+		panic("length not a power of 2")
+	}
+
+	var r []Stage
+	for size := 2; size <= n; size <<= 1 {
+		r = append(r, Stage{Size: size, Half: size / 2, Blocks: n / size})
+	}
+	return r
+}
+
 // reorderData returns a copy of x reordered for the DFT.
 func reorderData(x []complex128) []complex128 {
-	lx := uint(len(x))
+	return reorderStrided(x, len(x), 1, 0)
+}
+
+// reorderStrided returns the n elements x[offset], x[offset+stride], ...
+// reordered for the DFT.
+func reorderStrided(x []complex128, n, stride, offset int) []complex128 {
+	lx := uint(n)
 	r := make([]complex128, lx)
 	s := log2(lx)
 
-	var n uint
-	for ; n < lx; n++ {
-		r[reverseBits(n, s)] = x[n]
+	var i uint
+	for ; i < lx; i++ {
+		r[reverseBits(i, s)] = x[offset+int(i)*stride]
 	}
 
 	return r
 }
 
+// reorderInPlace reorders x for the DFT, like reorderData, by swapping
+// elements in place.
+func reorderInPlace(x []complex128) {
+	lx := uint(len(x))
+	s := log2(lx)
+
+	var i uint
+	for ; i < lx; i++ {
+		if j := reverseBits(i, s); i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+}
+
 // log2 returns the log base 2 of v
 // from: http://graphics.stanford.edu/~seander/bithacks.html#IntegerLogObvious
 func log2(v uint) uint {
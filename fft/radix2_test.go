@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// scheduleFFT computes the FFT of x from the schedule returned by StageParams.
+func scheduleFFT(x []complex128) []complex128 {
+	n := len(x)
+	bits := 0
+	for 1<<bits < n {
+		bits++
+	}
+
+	r := make([]complex128, n)
+	for i, v := range x {
+		j := 0
+		for b := range bits {
+			j |= (i >> b & 1) << (bits - 1 - b)
+		}
+		r[j] = v
+	}
+
+	for _, st := range StageParams(n) {
+		for nb := 0; nb < n; nb += st.Size {
+			for j := range st.Half {
+				w := cmplx.Exp(complex(0, -2*math.Pi*float64(st.Blocks*j)/float64(n)))
+				a, b := r[nb+j], r[nb+j+st.Half]
+				r[nb+j], r[nb+j+st.Half] = a+w*b, a-w*b
+			}
+		}
+	}
+
+	return r
+}
+
+func TestStageParams(t *testing.T) {
+	if s := StageParams(1); len(s) != 0 {
+		t.Error("StageParams error\ninput:", 1, "\noutput:", s, "\nexpected: []")
+	}
+
+	e := []Stage{{2, 1, 4}, {4, 2, 2}, {8, 4, 1}}
+	if s := StageParams(8); len(s) != len(e) {
+		t.Error("StageParams error\ninput:", 8, "\noutput:", s, "\nexpected:", e)
+	} else {
+		for i := range s {
+			if s[i] != e[i] {
+				t.Error("StageParams error\ninput:", 8, "\noutput:", s, "\nexpected:", e)
+				break
+			}
+		}
+	}
+
+	for _, n := range []int{2, 4, 16, 256, 1024} {
+		x := randomMatrix(1, n, int64(n))[0]
+		if v, f := scheduleFFT(x), FFT(x); !dsputils.PrettyCloseC(v, f) {
+			t.Error("StageParams schedule error\nlength:", n, "\noutput:", v, "\nexpected:", f)
+		}
+	}
+}
+
+// noiseFloor returns the largest magnitude of the FFT of a bin-centered unit
+// tone outside its bins, relative to its peak.
+func noiseFloor(n int) float64 {
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = cmplx.Exp(complex(0, 2*math.Pi*float64(1001*i%n)/float64(n)))
+	}
+
+	var floor float64
+	for k, v := range FFT(x) {
+		if k != 1001 {
+			floor = max(floor, cmplx.Abs(v))
+		}
+	}
+	return floor / float64(n)
+}
+
+func TestSetTwiddleMode(t *testing.T) {
+	const n = 1 << 20
+
+	accurate := noiseFloor(n)
+
+	SetTwiddleMode(Fast)
+	defer SetTwiddleMode(Accurate)
+	fast := noiseFloor(n)
+
+	if !(accurate < fast) {
+		t.Error("SetTwiddleMode error\naccurate floor:", accurate, "\nfast floor:", fast)
+	}
+
+	// both modes compute correct transforms
+	x := randomMatrix(1, 256, 1)[0]
+	if v, e := FFT(x), DFT(x); !dsputils.PrettyCloseC(v, e) {
+		t.Error("SetTwiddleMode Fast error\noutput:", v, "\nexpected:", e)
+	}
+}
+
+// conjIFFT computes the inverse FFT of x with the conjugate trick:
+// conj(FFT(conj(x)))/N.
+func conjIFFT(x []complex128) []complex128 {
+	r := make([]complex128, len(x))
+	for i, v := range x {
+		r[i] = cmplx.Conj(v)
+	}
+	r = radix2FFT(r)
+
+	N := complex(float64(len(r)), 0)
+	for i, v := range r {
+		r[i] = cmplx.Conj(v) / N
+	}
+	return r
+}
+
+func TestRadix2IFFT(t *testing.T) {
+	for _, n := range []int{2, 4, 8, 64, 1024, 1 << 14} {
+		x := randomMatrix(1, n, int64(n))[0]
+		v, e := radix2IFFT(x), conjIFFT(x)
+		for i := range v {
+			if v[i] != e[i] {
+				t.Error("radix2IFFT error\nlength:", n, "\nindex:", i, "\noutput:", v[i], "\nexpected:", e[i])
+				break
+			}
+		}
+		if r := IFFT(FFT(x)); !dsputils.PrettyCloseC(r, x) {
+			t.Error("IFFT round trip error\nlength:", n)
+		}
+	}
+}
+
+func BenchmarkRadix2IFFT(b *testing.B) {
+	x := randomMatrix(1, 1<<16, 1)[0]
+	EnsureRadix2Factors(len(x))
+
+	b.Run("direct", func(b *testing.B) {
+		for b.Loop() {
+			radix2IFFT(x)
+		}
+	})
+	b.Run("conjugate", func(b *testing.B) {
+		for b.Loop() {
+			conjIFFT(x)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// RFFT returns the non-negative frequency terms of the FFT of the real-valued
+// x: the len(x)/2+1 bins from DC up to the Nyquist frequency for even lengths,
+// or up to the last bin below it for odd lengths. The remaining bins are the
+// complex conjugates of these.
+// For even lengths, the result is computed with a single complex FFT of half
+// the length.
+func RFFT(x []float64) []complex128 {
+	lx := len(x)
+	if lx == 0 {
+		return []complex128{}
+	}
+	if lx%2 == 1 || lx < 4 {
+		return FFTReal(x)[:lx/2+1]
+	}
+
+	// Pack the even and odd samples into the real and imaginary parts of a
+	// half-length signal, then separate their spectra.
+	h := lx / 2
+	z := make([]complex128, h)
+	for n := range z {
+		z[n] = complex(x[2*n], x[2*n+1])
+	}
+	z = FFT(z)
+
+	r := make([]complex128, h+1)
+	for k := range r {
+		zk, zc := z[k%h], cmplx.Conj(z[(h-k)%h])
+		e := (zk + zc) / 2
+		o := (zk - zc) / complex(0, 2)
+		s, c := math.Sincos(-2 * math.Pi * float64(k) / float64(lx))
+		r[k] = e + complex(c, s)*o
+	}
+
+	return r
+}
+
+// IRFFT returns the real-valued inverse FFT of length n of the non-negative
+// frequency terms x, as returned by RFFT. n is needed because RFFT returns
+// the same number of bins for lengths 2m and 2m+1; len(x) must be n/2+1.
+// The imaginary parts of the DC and, for even n, Nyquist bins are ignored.
+// It panics if len(x) is not n/2+1; see IRFFTE.
+func IRFFT(x []complex128, n int) []float64 {
+	if n == 0 && len(x) == 0 {
+		return []float64{}
+	}
+	if n < 1 || len(x) != n/2+1 {
+		panic("invalid spectrum length")
+	}
+
+	full := make([]complex128, n)
+	copy(full, x)
+	for k := 1; k < len(x); k++ {
+		full[n-k] = cmplx.Conj(x[k])
+	}
+	full[0] = complex(real(x[0]), 0)
+	if n%2 == 0 {
+		full[n/2] = complex(real(x[n/2]), 0)
+	}
+
+	return IRFFTFull(full)
+}
+
+// IRFFTE is like IRFFT, but returns an error wrapping dsputils.ErrBadLength
+// instead of panicking if len(x) is not n/2+1.
+func IRFFTE(x []complex128, n int) ([]float64, error) {
+	if !(n == 0 && len(x) == 0) && (n < 1 || len(x) != n/2+1) {
+		return nil, fmt.Errorf("fft: %d bins for length %d: %w", len(x), n, dsputils.ErrBadLength)
+	}
+
+	return IRFFT(x, n), nil
+}
+
+// ConvolveReal returns the circular convolution of the real-valued x ∗ y,
+// like Convolve, computed with RFFT and IRFFT. It does about half the work of
+// Convolve, and the result is real by construction. It panics if x and y have
+// different lengths.
+// For linear convolution of long signals, see FFTConvolver and
+// PartitionedConvolver.
+func ConvolveReal(x, y []float64) []float64 {
+	if len(x) != len(y) {
+		panic("arrays not of equal size")
+	}
+
+	fx := RFFT(x)
+	fy := RFFT(y)
+	for i := range fx {
+		fx[i] *= fy[i]
+	}
+
+	return IRFFT(fx, len(x))
+}
+
+// IRFFTFull returns the inverse FFT of x, which must be conjugate-symmetric
+// (x[k] == conj(x[len(x)-k]), as is the FFT of any real-valued slice), so
+// that the result is real-valued.
+// For even lengths, the result is computed with a single complex inverse FFT
+// of half the length. The symmetry is not checked: if it does not hold, the
+// result is not the real part of IFFT(x), and is meaningless.
+func IRFFTFull(x []complex128) []float64 {
+	lx := len(x)
+	if lx == 0 {
+		return []float64{}
+	}
+	if lx%2 == 1 {
+		r := make([]float64, lx)
+		for i, v := range IFFT(x) {
+			r[i] = real(v)
+		}
+		return r
+	}
+
+	// Split x into the spectra of the even and odd samples, E and O, and
+	// transform E + jO, whose real and imaginary parts are the even and odd
+	// samples.
+	h := lx / 2
+	z := make([]complex128, h)
+	for k := range z {
+		s, c := math.Sincos(2 * math.Pi * float64(k) / float64(lx))
+		e := (x[k] + x[k+h]) / 2
+		o := (x[k] - x[k+h]) / 2 * complex(c, s)
+		z[k] = e + complex(0, 1)*o
+	}
+
+	r := make([]float64, lx)
+	for n, v := range IFFT(z) {
+		r[2*n] = real(v)
+		r[2*n+1] = imag(v)
+	}
+
+	return r
+}
+
+// DCComponent returns the DC (zero frequency) component of freq, the RFFT or
+// FFT of a real-valued signal, as a real number. It panics if freq is empty
+// or if the imaginary part of the DC bin is not close to zero, which means
+// freq is not the spectrum of a real-valued signal.
+func DCComponent(freq []complex128) float64 {
+	if len(freq) == 0 {
+		panic("empty spectrum")
+	}
+	return realBin(freq, 0)
+}
+
+// NyquistComponent returns the Nyquist frequency component of freq, the RFFT
+// or FFT of a real-valued signal of length n, as a real number. It panics if
+// n is odd, since odd lengths have no Nyquist bin, if freq is too short, or
+// if the imaginary part of the Nyquist bin is not close to zero, which means
+// freq is not the spectrum of a real-valued signal.
+func NyquistComponent(freq []complex128, n int) float64 {
+	if n < 2 || n%2 == 1 {
+		panic("no Nyquist bin for odd length")
+	}
+	if len(freq) <= n/2 {
+		panic("invalid spectrum length")
+	}
+	return realBin(freq, n/2)
+}
+
+// realBin returns the real part of freq[k], which must have an imaginary part
+// that is negligible compared to the largest magnitude in freq.
+func realBin(freq []complex128, k int) float64 {
+	var m float64
+	for _, v := range freq {
+		m = max(m, cmplx.Abs(v))
+	}
+	if math.Abs(imag(freq[k])) > 1e-9*(1+m) {
+		panic("imaginary part of a real-valued bin")
+	}
+	return real(freq[k])
+}
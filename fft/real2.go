@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+// RFFT2 returns the non-negative frequency terms of the 2-dimensional FFT of
+// the real-valued matrix x, like NumPy's fft.rfft2. The half spectrum is
+// along the second axis: row i of the result holds the len(x[0])/2+1
+// column frequencies returned by RFFT, for row frequency i of all len(x). The
+// remaining terms of the full FFT2 follow from symmetry:
+// FFT2(x)[i][j] == conj(RFFT2(x)[(rows-i)%rows][cols-j]).
+// The rows are transformed with RFFT, so the result costs about half as much
+// as FFT2Real.
+func RFFT2(x [][]float64) [][]complex128 {
+	rows := len(x)
+	if rows == 0 {
+		panic("empty input array")
+	}
+
+	cols := len(x[0])
+	r := make([][]complex128, rows)
+	for i, v := range x {
+		if len(v) != cols {
+			panic("ragged input array")
+		}
+		r[i] = RFFT(v)
+	}
+
+	transformColumns(r, FFT)
+	return r
+}
+
+// IRFFT2 returns the real-valued 2-dimensional inverse FFT with cols columns
+// of x, the non-negative frequency terms returned by RFFT2. cols is needed
+// because RFFT2 returns the same number of columns for 2m and 2m+1; each row
+// of x must have cols/2+1 elements.
+func IRFFT2(x [][]complex128, cols int) [][]float64 {
+	rows := len(x)
+	if rows == 0 {
+		panic("empty input array")
+	}
+
+	c := make([][]complex128, rows)
+	for i, v := range x {
+		if len(v) != cols/2+1 {
+			panic("invalid spectrum length")
+		}
+		c[i] = append([]complex128(nil), v...)
+	}
+
+	transformColumns(c, IFFT)
+	r := make([][]float64, rows)
+	for i, v := range c {
+		r[i] = IRFFT(v, cols)
+	}
+
+	return r
+}
+
+// transformColumns replaces each column of x with its transform by fftFunc.
+func transformColumns(x [][]complex128, fftFunc func([]complex128) []complex128) {
+	col := make([]complex128, len(x))
+	for j := range x[0] {
+		for i, v := range x {
+			col[i] = v[j]
+		}
+		for i, v := range fftFunc(col) {
+			x[i][j] = v
+		}
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestRFFT2(t *testing.T) {
+	for _, sz := range [][2]int{{1, 1}, {3, 4}, {4, 5}, {8, 8}, {6, 15}} {
+		rows, cols := sz[0], sz[1]
+		x := make([][]float64, rows)
+		for i := range x {
+			x[i] = randomReal(cols, int64(i))
+		}
+
+		v := RFFT2(x)
+		full := FFT2Real(x)
+		if len(v) != rows {
+			t.Error("RFFT2 rows error\ninput:", sz, "\noutput:", len(v), "\nexpected:", rows)
+			continue
+		}
+		for i := range v {
+			if e := full[i][:cols/2+1]; !dsputils.PrettyCloseC(v[i], e) {
+				t.Error("RFFT2 error\ninput:", sz, "\nrow:", i, "\noutput:", v[i], "\nexpected:", e)
+			}
+		}
+
+		r := IRFFT2(v, cols)
+		for i := range r {
+			for j := range r[i] {
+				if math.Abs(r[i][j]-x[i][j]) > 1e-9 {
+					t.Error("IRFFT2 error\ninput:", sz, "\nindex:", i, j, "\noutput:", r[i][j], "\nexpected:", x[i][j])
+				}
+			}
+		}
+	}
+}
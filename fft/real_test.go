@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func randomReal(n int, seed int64) []float64 {
+	rnd := rand.New(rand.NewSource(seed))
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	return x
+}
+
+func TestIRFFTFull(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 8, 15, 16, 100, 1024} {
+		x := randomReal(n, int64(n))
+		v := IRFFTFull(FFTReal(x))
+
+		if len(v) != n {
+			t.Error("IRFFTFull length error\noutput:", len(v), "\nexpected:", n)
+			continue
+		}
+		for i := range v {
+			if math.Abs(v[i]-x[i]) > 1e-12 {
+				t.Error("IRFFTFull error\nlength:", n, "\nindex:", i, "\noutput:", v[i], "\nexpected:", x[i])
+				break
+			}
+		}
+	}
+
+	if v := IRFFTFull(nil); len(v) != 0 {
+		t.Error("IRFFTFull error\noutput:", v, "\nexpected: []")
+	}
+}
+
+func TestRFFT(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 4, 7, 8, 15, 16, 100, 4095, 4096} {
+		x := randomReal(n, int64(n))
+		v := RFFT(x)
+
+		if len(v) != n/2+1 {
+			t.Error("RFFT length error\ninput:", n, "\noutput:", len(v), "\nexpected:", n/2+1)
+			continue
+		}
+		if e := FFTReal(x)[:n/2+1]; !dsputils.PrettyCloseC(v, e) {
+			t.Error("RFFT error\nlength:", n, "\noutput:", v, "\nexpected:", e)
+		}
+
+		r := IRFFT(v, n)
+		if len(r) != n {
+			t.Error("IRFFT length error\ninput:", n, "\noutput:", len(r), "\nexpected:", n)
+			continue
+		}
+		for i := range r {
+			if math.Abs(r[i]-x[i]) > 1e-9 {
+				t.Error("IRFFT error\nlength:", n, "\nindex:", i, "\noutput:", r[i], "\nexpected:", x[i])
+				break
+			}
+		}
+	}
+
+	if r := IRFFT(RFFT(nil), 0); len(r) != 0 {
+		t.Error("IRFFT error\noutput:", r, "\nexpected: []")
+	}
+}
+
+func TestIRFFTE(t *testing.T) {
+	x := RFFT(randomReal(8, 1))
+	for _, n := range []int{7, 10, 0, -1} {
+		if r, err := IRFFTE(x, n); !errors.Is(err, dsputils.ErrBadLength) || r != nil {
+			t.Error("IRFFTE error\nlength:", n, "\noutput:", r, err, "\nexpected:", dsputils.ErrBadLength)
+		}
+	}
+
+	for _, n := range []int{8, 9} {
+		r, err := IRFFTE(x, n)
+		if e := IRFFT(x, n); err != nil || !dsputils.PrettyClose(r, e) {
+			t.Error("IRFFTE error\nlength:", n, "\noutput:", r, err, "\nexpected:", e)
+		}
+	}
+}
+
+func TestDCNyquistComponent(t *testing.T) {
+	for _, n := range []int{2, 8, 100, 1024} {
+		x := randomReal(n, int64(n))
+		var dc, ny float64
+		for i, v := range x {
+			dc += v
+			if i%2 == 0 {
+				ny += v
+			} else {
+				ny -= v
+			}
+		}
+
+		f := RFFT(x)
+		if v := DCComponent(f); math.Abs(v-dc) > 1e-9 {
+			t.Error("DCComponent error\nlength:", n, "\noutput:", v, "\nexpected:", dc)
+		}
+		if v := NyquistComponent(f, n); math.Abs(v-ny) > 1e-9 {
+			t.Error("NyquistComponent error\nlength:", n, "\noutput:", v, "\nexpected:", ny)
+		}
+		if v := NyquistComponent(FFTReal(x), n); math.Abs(v-ny) > 1e-9 {
+			t.Error("NyquistComponent full spectrum error\nlength:", n, "\noutput:", v, "\nexpected:", ny)
+		}
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"imaginary DC", func() { DCComponent([]complex128{1i, 2, 3}) }},
+		{"imaginary Nyquist", func() { NyquistComponent([]complex128{1, 2, 3 + 1i}, 4) }},
+		{"odd length", func() { NyquistComponent(RFFT(randomReal(5, 1)), 5) }},
+		{"empty", func() { DCComponent(nil) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error(tt.name, "error\nexpected panic")
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
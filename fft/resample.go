@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+// ResampleFFT returns x resampled to num samples by truncating or
+// zero-padding its spectrum, like SciPy's resample. x is assumed periodic and
+// bandlimited, for which the result is exact; otherwise, expect ringing at the
+// edges. When the shorter of the two lengths is even, its Nyquist bin is
+// split evenly between the positive and negative frequencies on upsampling,
+// and folded back on downsampling, so that the result is consistent with
+// resampling the real-valued periodic signal.
+func ResampleFFT(x []float64, num int) []float64 {
+	if num < 0 {
+		panic("invalid length")
+	}
+	n := len(x)
+	if n == 0 || num == 0 {
+		return make([]float64, num)
+	}
+
+	X := RFFT(x)
+	Y := make([]complex128, num/2+1)
+	m := min(n, num)
+	copy(Y, X[:m/2+1])
+
+	if m%2 == 0 {
+		switch {
+		case num < n:
+			Y[m/2] *= 2
+		case num > n:
+			Y[m/2] /= 2
+		}
+	}
+
+	y := IRFFT(Y, num)
+	s := float64(num) / float64(n)
+	for i := range y {
+		y[i] *= s
+	}
+	return y
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResampleFFT(t *testing.T) {
+	// periodic tones, including one at the Nyquist frequency of n
+	for _, tt := range []struct {
+		n, num int
+		cycles float64
+	}{
+		{64, 160, 5},
+		{64, 128, 32},
+		{64, 100, 32},
+		{63, 200, 7},
+		{100, 40, 13},
+		{100, 41, 3},
+	} {
+		x := make([]float64, tt.n)
+		for i := range x {
+			x[i] = math.Cos(2*math.Pi*tt.cycles*float64(i)/float64(tt.n) + 0.3)
+		}
+		if tt.cycles == float64(tt.n)/2 {
+			// at Nyquist only the cosine is sampled
+			for i := range x {
+				x[i] = math.Cos(math.Pi * float64(i))
+			}
+		}
+
+		y := ResampleFFT(x, tt.num)
+		if len(y) != tt.num {
+			t.Error("ResampleFFT length error\ninput:", tt.n, tt.num, "\noutput:", len(y), "\nexpected:", tt.num)
+			continue
+		}
+
+		phase := 0.3
+		if tt.cycles == float64(tt.n)/2 {
+			phase = 0
+		}
+		for i, v := range y {
+			e := math.Cos(2*math.Pi*tt.cycles*float64(i)/float64(tt.num) + phase)
+			if math.Abs(v-e) > 1e-9 {
+				t.Error("ResampleFFT error\ninput:", tt.n, tt.num, tt.cycles, "\nindex:", i, "\noutput:", v, "\nexpected:", e)
+				break
+			}
+		}
+	}
+
+	if y := ResampleFFT(nil, 3); len(y) != 3 {
+		t.Error("ResampleFFT empty error\noutput:", y)
+	}
+}
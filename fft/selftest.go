@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"fmt"
+	"math/cmplx"
+	"math/rand"
+)
+
+// SelfTest checks FFT and IFFT against a direct DFT for a range of power of
+// 2 and other lengths, using the current backend and settings. It returns an
+// error describing the first bin whose error exceeds the tolerance, or nil.
+// Downstream projects can call it in their tests to check that the transforms
+// work correctly on their hardware.
+func SelfTest() error {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 8, 12, 16, 63, 64, 100, 128, 1000, 1024} {
+		x := make([]complex128, n)
+		for i := range x {
+			x[i] = complex(r.NormFloat64(), r.NormFloat64())
+		}
+
+		// the errors of an accurate FFT grow roughly as log n, far below this
+		tol := 1e-12 * float64(n)
+		if err := checkBins("FFT", n, FFT(x), DFT(x), tol); err != nil {
+			return err
+		}
+		if err := checkBins("IFFT", n, IFFT(x), IDFT(x), tol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkBins(name string, n int, out, exp []complex128, tol float64) error {
+	if len(out) != n {
+		return fmt.Errorf("fft: self test: %s of length %d returned %d bins", name, n, len(out))
+	}
+	for k := range out {
+		if e := cmplx.Abs(out[k] - exp[k]); !(e <= tol) {
+			return fmt.Errorf("fft: self test: %s of length %d: bin %d error %g exceeds %g", name, n, k, e, tol)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+)
+
+// conjBackend computes the FFT with the wrong sign, which SelfTest must catch.
+type conjBackend struct{}
+
+func (conjBackend) FFT(dst, src []complex128)  { builtinBackend{}.IFFT(dst, src) }
+func (conjBackend) IFFT(dst, src []complex128) { builtinBackend{}.IFFT(dst, src) }
+
+func TestSelfTest(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Error("SelfTest error\noutput:", err, "\nexpected: nil")
+	}
+
+	for _, workers := range []int{1, 3} {
+		SetWorkerPoolSize(workers)
+		if err := SelfTest(); err != nil {
+			t.Error("SelfTest error\nworkers:", workers, "\noutput:", err, "\nexpected: nil")
+		}
+	}
+	SetWorkerPoolSize(0)
+
+	SetBackend(conjBackend{})
+	defer SetBackend(nil)
+	if err := SelfTest(); err == nil {
+		t.Error("SelfTest error\noutput: nil\nexpected: error for broken backend")
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math/cmplx"
+)
+
+// Spectrum is the FFT of a signal, with accessors for the magnitude, phase,
+// and frequency of each bin that avoid building separate slices of them.
+// The bins are in FFT order, as returned by FFT.
+type Spectrum []complex128
+
+// Len returns the number of bins of s.
+func (s Spectrum) Len() int {
+	return len(s)
+}
+
+// Mag returns the magnitude of bin i.
+func (s Spectrum) Mag(i int) float64 {
+	return cmplx.Abs(s[i])
+}
+
+// Phase returns the phase of bin i, in radians in the range [-Pi, Pi].
+func (s Spectrum) Phase(i int) float64 {
+	return cmplx.Phase(s[i])
+}
+
+// Freq returns the frequency of bin i for the sampling frequency fs, with the
+// same convention as dsputils.FFTFreq: bins from (Len()+1)/2 up have negative
+// frequencies.
+func (s Spectrum) Freq(i int, fs float64) float64 {
+	n := len(s)
+	if i < 0 || i >= n {
+		panic("bin out of range")
+	}
+	k := i
+	if i >= (n+1)/2 {
+		k = i - n
+	}
+	return float64(k) * fs / float64(n)
+}
@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestSpectrum(t *testing.T) {
+	for _, n := range []int{1, 7, 16} {
+		x := FFTReal(randomReal(n, int64(n)))
+		s := Spectrum(x)
+		if s.Len() != n {
+			t.Error("Spectrum length error\noutput:", s.Len(), "\nexpected:", n)
+		}
+
+		freqs := dsputils.FFTFreq(n, 1/8000.0)
+		for i, v := range x {
+			if o, e := s.Mag(i), cmplx.Abs(v); !dsputils.Float64Equal(o, e) {
+				t.Error("Spectrum.Mag error\nindex:", i, "\noutput:", o, "\nexpected:", e)
+			}
+			if o, e := s.Phase(i), cmplx.Phase(v); !dsputils.Float64Equal(o, e) {
+				t.Error("Spectrum.Phase error\nindex:", i, "\noutput:", o, "\nexpected:", e)
+			}
+			if o, e := s.Freq(i, 8000), freqs[i]; !dsputils.Float64Equal(o, e) {
+				t.Error("Spectrum.Freq error\nindex:", i, "\noutput:", o, "\nexpected:", e)
+			}
+		}
+	}
+}
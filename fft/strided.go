@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+// FFTStrided stores in dst the forward FFT of the len(dst) elements
+// src[offset], src[offset+stride], src[offset+2*stride], ..., such as one
+// channel of interleaved multichannel data. When the built-in backend uses
+// Radix2, as it does by default for power of 2 lengths, the elements are read
+// directly into the transform's working buffer, so the channel is never
+// copied out.
+// It panics if stride is less than 1, offset is negative, or the view
+// extends past the end of src.
+func FFTStrided(dst, src []complex128, stride, offset int) {
+	n := len(dst)
+	if stride < 1 || offset < 0 {
+		panic("invalid stride or offset")
+	}
+	if n == 0 {
+		return
+	}
+	if offset+(n-1)*stride >= len(src) {
+		panic("strided view out of range")
+	}
+
+	if _, ok := currentBackend().(builtinBackend); ok && !flush_denormals && n > 1 && algorithmFor(n) == Radix2 {
+		copy(dst, radix2Transform(reorderStrided(src, n, stride, offset), false))
+		return
+	}
+
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = src[offset+i*stride]
+	}
+	copy(dst, FFT(x))
+}
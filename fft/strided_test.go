@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestFFTStrided(t *testing.T) {
+	for _, n := range []int{1, 2, 8, 100, 1024} {
+		for _, st := range []struct{ stride, offset int }{{1, 0}, {2, 0}, {2, 1}, {3, 2}} {
+			src := randomMatrix(1, n*st.stride+st.offset, int64(n))[0]
+
+			ch := make([]complex128, n)
+			for i := range ch {
+				ch[i] = src[st.offset+i*st.stride]
+			}
+			e := FFT(ch)
+
+			dst := make([]complex128, n)
+			FFTStrided(dst, src, st.stride, st.offset)
+			if !dsputils.PrettyCloseC(dst, e) {
+				t.Error("FFTStrided error\nlength:", n, "\nstride:", st.stride, "\noffset:", st.offset, "\noutput:", dst, "\nexpected:", e)
+			}
+		}
+	}
+
+	// the last element of the view may be the last element of src
+	src := []complex128{1, 0, 2, 0, 3, 0, 4}
+	dst := make([]complex128, 4)
+	FFTStrided(dst, src, 2, 0)
+	if e := FFT([]complex128{1, 2, 3, 4}); !dsputils.PrettyCloseC(dst, e) {
+		t.Error("FFTStrided error\ninput:", src, "\noutput:", dst, "\nexpected:", e)
+	}
+}
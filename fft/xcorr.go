@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// XCorrComplex returns the full cross-correlation of the complex-valued
+// signals a and b, computed via the FFT, and the lag of each element:
+//
+//	r[i] = sum over n of a[n+lags[i]] * conj(b[n])
+//
+// for lags from -(len(b)-1) to len(a)-1. b is conjugated, as in NumPy's
+// correlate, so the cross-correlation of a signal with itself is its
+// autocorrelation, which is real and positive at lag 0, where it equals the
+// signal's energy.
+func XCorrComplex(a, b []complex128) ([]complex128, []int) {
+	if len(a) == 0 || len(b) == 0 {
+		return []complex128{}, []int{}
+	}
+
+	nr := len(a) + len(b) - 1
+	n := dsputils.NextPowerOf2(nr)
+	af := FFT(dsputils.ZeroPad(a, n))
+	bf := FFT(dsputils.ZeroPad(b, n))
+	for i, v := range bf {
+		af[i] *= cmplx.Conj(v)
+	}
+	c := IFFT(af)
+
+	// negative lags wrap around to the end of the circular correlation
+	r := make([]complex128, nr)
+	lags := make([]int, nr)
+	for i := range r {
+		l := i - (len(b) - 1)
+		lags[i] = l
+		r[i] = c[(l+n)%n]
+	}
+
+	return r, lags
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package fft
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// directXCorr returns the full cross-correlation of a and b from its
+// definition.
+func directXCorr(a, b []complex128) []complex128 {
+	r := make([]complex128, len(a)+len(b)-1)
+	for i := range r {
+		l := i - (len(b) - 1)
+		for n, v := range b {
+			if m := n + l; m >= 0 && m < len(a) {
+				r[i] += a[m] * cmplx.Conj(v)
+			}
+		}
+	}
+	return r
+}
+
+func TestXCorrComplex(t *testing.T) {
+	for _, tt := range []struct{ la, lb int }{{1, 1}, {5, 3}, {3, 5}, {100, 37}, {64, 64}} {
+		a := randomMatrix(1, tt.la, 1)[0]
+		b := randomMatrix(1, tt.lb, 2)[0]
+
+		r, lags := XCorrComplex(a, b)
+		if e := directXCorr(a, b); !dsputils.PrettyCloseC(r, e) {
+			t.Error("XCorrComplex error\nlengths:", tt.la, tt.lb, "\noutput:", r, "\nexpected:", e)
+		}
+		if lags[0] != -(tt.lb-1) || lags[len(lags)-1] != tt.la-1 {
+			t.Error("XCorrComplex lags error\nlengths:", tt.la, tt.lb, "\noutput:", lags[0], lags[len(lags)-1], "\nexpected:", -(tt.lb - 1), tt.la-1)
+		}
+
+		// the autocorrelation peaks at lag 0 with the energy, and is real there
+		r, lags = XCorrComplex(a, a)
+		var energy float64
+		for _, v := range a {
+			energy += real(v)*real(v) + imag(v)*imag(v)
+		}
+		peak := 0
+		for i, v := range r {
+			if cmplx.Abs(v) > cmplx.Abs(r[peak]) {
+				peak = i
+			}
+		}
+		if lags[peak] != 0 {
+			t.Error("XCorrComplex autocorrelation peak error\nlength:", tt.la, "\noutput:", lags[peak], "\nexpected:", 0)
+		}
+		if v := r[peak]; math.Abs(real(v)-energy) > 1e-9 || math.Abs(imag(v)) > 1e-9 {
+			t.Error("XCorrComplex autocorrelation error\nlength:", tt.la, "\noutput:", v, "\nexpected:", energy)
+		}
+	}
+
+	if r, lags := XCorrComplex(nil, []complex128{1}); len(r) != 0 || len(lags) != 0 {
+		t.Error("XCorrComplex empty error\noutput:", r, lags)
+	}
+}
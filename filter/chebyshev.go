@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// ChebyshevI returns an order-order digital Chebyshev type I filter of kind
+// with ripple dB of peak-to-peak ripple in the passband. cutoff is the
+// normalized passband edge frequency (where 1 is the Nyquist frequency), at
+// which the gain first drops below -ripple dB.
+// Reference: http://docs.scipy.org/doc/scipy/reference/generated/scipy.signal.cheby1.html
+func ChebyshevI(order int, ripple, cutoff float64, kind FilterKind) SOS {
+	if order < 1 {
+		panic("invalid filter order")
+	}
+	if ripple <= 0 {
+		panic("invalid ripple")
+	}
+
+	return cheb1ap(order, ripple).transform(cutoff, kind)
+}
+
+// ChebyshevII returns an order-order digital Chebyshev type II filter of kind
+// with a stopband attenuation of atten dB. cutoff is the normalized stopband
+// edge frequency (where 1 is the Nyquist frequency), at which the gain first
+// reaches -atten dB.
+// Reference: http://docs.scipy.org/doc/scipy/reference/generated/scipy.signal.cheby2.html
+func ChebyshevII(order int, atten, cutoff float64, kind FilterKind) SOS {
+	if order < 1 {
+		panic("invalid filter order")
+	}
+	if atten <= 0 {
+		panic("invalid attenuation")
+	}
+
+	return cheb2ap(order, atten).transform(cutoff, kind)
+}
+
+// cheb1ap returns the analog Chebyshev type I lowpass prototype with
+// passband edge 1 rad/s.
+func cheb1ap(n int, rp float64) zpk {
+	eps := math.Sqrt(math.Pow(10, rp/10) - 1)
+	mu := math.Asinh(1/eps) / float64(n)
+
+	p := make([]complex128, n)
+	for i := range p {
+		theta := math.Pi * float64(2*i-n+1) / float64(2*n)
+		p[i] = -cmplx.Sinh(complex(mu, theta))
+	}
+
+	k := real(prod(neg(p)))
+	if n%2 == 0 {
+		k /= math.Sqrt(1 + eps*eps)
+	}
+
+	return zpk{nil, p, k}
+}
+
+// cheb2ap returns the analog Chebyshev type II lowpass prototype with
+// stopband edge 1 rad/s.
+func cheb2ap(n int, rs float64) zpk {
+	de := 1 / math.Sqrt(math.Pow(10, rs/10)-1)
+	mu := math.Asinh(1/de) / float64(n)
+
+	var z []complex128
+	for m := -n + 1; m < n; m += 2 {
+		if m == 0 {
+			continue
+		}
+		z = append(z, complex(0, 1/math.Sin(float64(m)*math.Pi/float64(2*n))))
+	}
+
+	p := make([]complex128, n)
+	for i := range p {
+		v := -cmplx.Exp(complex(0, math.Pi*float64(2*i-n+1)/float64(2*n)))
+		v = complex(math.Sinh(mu)*real(v), math.Cosh(mu)*imag(v))
+		p[i] = 1 / v
+	}
+
+	k := real(prod(neg(p)) / prod(neg(z)))
+	return zpk{z, p, k}
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// gainRange returns the minimum and maximum gain in dB of s over the
+// normalized frequencies [lo, hi].
+func gainRange(s SOS, lo, hi float64) (mn, mx float64) {
+	mn, mx = math.Inf(1), math.Inf(-1)
+	const n = 2000
+	for i := 0; i <= n; i++ {
+		w := lo + (hi-lo)*float64(i)/n
+		g := 20 * math.Log10(cmplx.Abs(s.Response(w)))
+		mn = math.Min(mn, g)
+		mx = math.Max(mx, g)
+	}
+	return
+}
+
+type chebyshevTest struct {
+	order        int
+	spec, cutoff float64
+	kind         FilterKind
+}
+
+var chebyshevTests = []chebyshevTest{
+	{4, 1, 0.3, Lowpass},
+	{5, 0.5, 0.4, Lowpass},
+	{3, 2, 0.25, Highpass},
+	{6, 1, 0.6, Highpass},
+}
+
+func TestChebyshevI(t *testing.T) {
+	for _, ct := range chebyshevTests {
+		s := ChebyshevI(ct.order, ct.spec, ct.cutoff, ct.kind)
+		lo, hi := 0.0, ct.cutoff
+		if ct.kind == Highpass {
+			lo, hi = ct.cutoff, 1
+		}
+
+		mn, mx := gainRange(s, lo, hi)
+		if mx > 1e-9 || math.Abs(mn+ct.spec) > 1e-6 {
+			t.Error("ChebyshevI passband ripple error\ninput:", ct, "\noutput:", mn, mx, "\nexpected:", -ct.spec, 0)
+		}
+	}
+}
+
+func TestChebyshevII(t *testing.T) {
+	for _, ct := range chebyshevTests {
+		atten := 20 * ct.spec
+		s := ChebyshevII(ct.order, atten, ct.cutoff, ct.kind)
+		slo, shi, plo := ct.cutoff, 1.0, 0.0
+		if ct.kind == Highpass {
+			slo, shi, plo = 0, ct.cutoff, 1
+		}
+
+		_, mx := gainRange(s, slo, shi)
+		if math.Abs(mx+atten) > 1e-6 {
+			t.Error("ChebyshevII stopband attenuation error\ninput:", ct, "\noutput:", mx, "\nexpected:", -atten)
+		}
+
+		if g := cmplx.Abs(s.Response(plo)); math.Abs(g-1) > 1e-9 {
+			t.Error("ChebyshevII passband gain error\ninput:", ct, "\noutput:", g, "\nexpected:", 1)
+		}
+	}
+}
+
+func TestSOSFilter(t *testing.T) {
+	s := ChebyshevI(4, 1, 0.3, Lowpass)
+	for _, w := range []float64{0.1, 0.5} {
+		x := make([]float64, 4000)
+		for i := range x {
+			x[i] = math.Cos(math.Pi * w * float64(i))
+		}
+
+		// steady-state amplitude of the output tone
+		y := s.Filter(x)
+		var c, d float64
+		for i := len(y) / 2; i < len(y); i++ {
+			c += y[i] * math.Cos(math.Pi*w*float64(i))
+			d += y[i] * math.Sin(math.Pi*w*float64(i))
+		}
+		amp := 2 * math.Hypot(c, d) / float64(len(y)/2)
+
+		if e := cmplx.Abs(s.Response(w)); math.Abs(amp-e) > 1e-6 {
+			t.Error("SOS Filter error\nfrequency:", w, "\noutput:", amp, "\nexpected:", e)
+		}
+	}
+}
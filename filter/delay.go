@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+)
+
+// DelayMethod specifies how FractionalDelay interpolates between samples.
+type DelayMethod int
+
+const (
+	// Lagrange uses a third-order Lagrange interpolating FIR filter.
+	Lagrange DelayMethod = iota
+	// Thiran uses a third-order Thiran all-pass IIR filter.
+	Thiran
+)
+
+// delayOrder is the order of the fractional-delay filters.
+const delayOrder = 3
+
+// FractionalDelay returns x delayed by delay samples, which need not be an
+// integer. The output has the same length as x; samples outside of x are
+// taken as zero.
+//
+// Lagrange interpolation has a maximally flat frequency response at DC and
+// its magnitude rolls off toward Nyquist. The Thiran all-pass filter has
+// exactly unit magnitude but its group delay is only maximally flat at DC.
+// Reference: https://ccrma.stanford.edu/~jos/pasp/Lagrange_Interpolation.html
+// Reference: https://ccrma.stanford.edu/~jos/pasp/Thiran_Allpass_Interpolators.html
+func FractionalDelay(x []float64, delay float64, method DelayMethod) []float64 {
+	var y []float64
+	var shift int
+
+	switch method {
+	case Lagrange:
+		// keep the fractional part near the center of the taps
+		shift = int(math.Floor(delay)) - (delayOrder-1)/2
+		y = lagrangeDelay(x, delay-float64(shift))
+	case Thiran:
+		// Thiran filters are stable and most accurate for delays near their order
+		shift = int(math.Floor(delay + 0.5 - delayOrder))
+		y = thiranDelay(x, delay-float64(shift))
+	default:
+		panic("unknown delay method")
+	}
+
+	r := make([]float64, len(x))
+	for i := range r {
+		if j := i - shift; j >= 0 && j < len(y) {
+			r[i] = y[j]
+		}
+	}
+	return r
+}
+
+// lagrangeDelay returns x filtered with the Lagrange interpolator for delay
+// d, extended by delayOrder samples.
+func lagrangeDelay(x []float64, d float64) []float64 {
+	var h [delayOrder + 1]float64
+	for n := range h {
+		h[n] = 1
+		for k := range h {
+			if k != n {
+				h[n] *= (d - float64(k)) / float64(n-k)
+			}
+		}
+	}
+
+	r := make([]float64, len(x)+delayOrder)
+	for i, v := range x {
+		for n, c := range h {
+			r[i+n] += c * v
+		}
+	}
+	return r
+}
+
+// thiranDelay returns x filtered with the Thiran all-pass for delay d,
+// extended by delayOrder samples.
+func thiranDelay(x []float64, d float64) []float64 {
+	const n = delayOrder
+
+	var a [n + 1]float64
+	for k := range a {
+		c := float64(binomial(n, k))
+		if k%2 == 1 {
+			c = -c
+		}
+		for i := 0; i <= n; i++ {
+			c *= (d - n + float64(i)) / (d - n + float64(k+i))
+		}
+		a[k] = c
+	}
+
+	// the numerator is the denominator reversed
+	r := make([]float64, len(x)+n)
+	for i := range r {
+		var s float64
+		for k := 0; k <= n && k <= i; k++ {
+			if i-k < len(x) {
+				s += a[n-k] * x[i-k]
+			}
+			if k > 0 {
+				s -= a[k] * r[i-k]
+			}
+		}
+		r[i] = s
+	}
+	return r
+}
+
+func binomial(n, k int) int {
+	r := 1
+	for i := 1; i <= k; i++ {
+		r = r * (n - i + 1) / i
+	}
+	return r
+}
@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFractionalDelay(t *testing.T) {
+	const w = 0.1 // radians per sample
+
+	x := make([]float64, 512)
+	for i := range x {
+		x[i] = math.Sin(w * float64(i))
+	}
+
+	for _, method := range []DelayMethod{Lagrange, Thiran} {
+		for _, d := range []float64{0.5, 0.25, 1, 2.7, 10.5} {
+			y := FractionalDelay(x, d, method)
+			if len(y) != len(x) {
+				t.Error("FractionalDelay length error\nmethod:", method, "\ndelay:", d, "\noutput:", len(y), "\nexpected:", len(x))
+				continue
+			}
+
+			// skip the transients at both ends
+			for i := 100; i < len(y)-delayOrder; i++ {
+				if e := math.Sin(w * (float64(i) - d)); math.Abs(y[i]-e) > 1e-3 {
+					t.Error("FractionalDelay error\nmethod:", method, "\ndelay:", d, "\nindex:", i, "\noutput:", y[i], "\nexpected:", e)
+					break
+				}
+			}
+		}
+	}
+
+	// integer delays through the Lagrange interpolator are exact shifts
+	y := FractionalDelay(x, 3, Lagrange)
+	for i := 3; i < len(y); i++ {
+		if math.Abs(y[i]-x[i-3]) > 1e-12 {
+			t.Error("FractionalDelay integer error\nindex:", i, "\noutput:", y[i], "\nexpected:", x[i-3])
+			break
+		}
+	}
+}
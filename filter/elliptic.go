@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Elliptic returns an order-order digital elliptic (Cauer) filter of kind
+// with passRipple dB of peak-to-peak ripple in the passband and a stopband
+// attenuation of stopAtten dB. cutoff is the normalized passband edge
+// frequency (where 1 is the Nyquist frequency), at which the gain first drops
+// below -passRipple dB.
+// Reference: http://docs.scipy.org/doc/scipy/reference/generated/scipy.signal.ellip.html
+func Elliptic(order int, passRipple, stopAtten, cutoff float64, kind FilterKind) SOS {
+	if order < 1 {
+		panic("invalid filter order")
+	}
+	if passRipple <= 0 || stopAtten <= passRipple {
+		panic("invalid ripple or attenuation")
+	}
+
+	return ellipap(order, passRipple, stopAtten).transform(cutoff, kind)
+}
+
+// ellipap returns the analog elliptic lowpass prototype with passband edge
+// 1 rad/s.
+// Reference: Lutovac, Tosic, and Evans, "Filter Design for Signal Processing
+// Using MATLAB and Mathematica," 2001.
+func ellipap(n int, rp, rs float64) zpk {
+	epsSq := math.Pow(10, rp/10) - 1
+	if n == 1 {
+		p := -math.Sqrt(1 / epsSq)
+		return zpk{nil, []complex128{complex(p, 0)}, -p}
+	}
+
+	eps := math.Sqrt(epsSq)
+	k1Sq := epsSq / (math.Pow(10, rs/10) - 1)
+	k1 := ellipK(1 - k1Sq)
+	k1c := ellipK(k1Sq)
+
+	// Solve the degree equation K(m)/K(1-m) = n*K(k1)/K(1-k1) for the
+	// selectivity parameter m, using its nome.
+	m, mc := nomeParam(math.Exp(-math.Pi * k1c / (float64(n) * k1)))
+	capK := ellipK(mc)
+
+	var z, p []complex128
+	r := ellipF(math.Atan(1/eps), k1Sq)
+	v0 := capK * r / (float64(n) * k1)
+	sv, cv, dv := ellipJ(v0, mc, m)
+
+	for j := 1 - n%2; j < n; j += 2 {
+		s, c, d := ellipJ(float64(j)*capK/float64(n), m, mc)
+
+		if math.Abs(s) > 1e-12 {
+			w := 1 / (math.Sqrt(m) * s)
+			z = append(z, complex(0, w), complex(0, -w))
+		}
+
+		den := 1 - d*d*sv*sv
+		v := complex(-c*d*sv*cv/den, -s*dv/den)
+		p = append(p, v)
+		if math.Abs(imag(v)) > 1e-12*cmplx.Abs(v) {
+			p = append(p, cmplx.Conj(v))
+		}
+	}
+
+	k := real(prod(neg(p)) / prod(neg(z)))
+	if n%2 == 0 {
+		k /= math.Sqrt(1 + epsSq)
+	}
+
+	return zpk{z, p, k}
+}
+
+// ellipK returns the complete elliptic integral of the first kind K(m),
+// given the complementary parameter mc = 1-m.
+func ellipK(mc float64) float64 {
+	a, b := 1.0, math.Sqrt(mc)
+	for math.Abs(a-b) > 1e-15*a {
+		a, b = (a+b)/2, math.Sqrt(a*b)
+	}
+	return math.Pi / (2 * a)
+}
+
+// ellipF returns the incomplete elliptic integral of the first kind F(phi|m),
+// for 0 <= phi <= pi/2, given the complementary parameter mc = 1-m.
+func ellipF(phi, mc float64) float64 {
+	s, c := math.Sincos(phi)
+	return s * carlsonRF(c*c, c*c+mc*s*s, 1)
+}
+
+// carlsonRF returns Carlson's symmetric elliptic integral of the first kind.
+// Reference: Numerical Recipes, 3rd edition, section 6.12.
+func carlsonRF(x, y, z float64) float64 {
+	for {
+		sx, sy, sz := math.Sqrt(x), math.Sqrt(y), math.Sqrt(z)
+		l := sx*(sy+sz) + sy*sz
+		x, y, z = (x+l)/4, (y+l)/4, (z+l)/4
+		a := (x + y + z) / 3
+		dx, dy, dz := (a-x)/a, (a-y)/a, (a-z)/a
+		if math.Max(math.Abs(dx), math.Max(math.Abs(dy), math.Abs(dz))) < 1e-4 {
+			e2 := dx*dy - dz*dz
+			e3 := dx * dy * dz
+			return (1 + (e2/24-0.1-3*e3/44)*e2 + e3/14) / math.Sqrt(a)
+		}
+	}
+}
+
+// ellipJ returns the Jacobi elliptic functions sn, cn, and dn of u with
+// parameter m and complementary parameter mc = 1-m, using the arithmetic-
+// geometric mean.
+// Reference: Abramowitz and Stegun, section 16.4.
+func ellipJ(u, m, mc float64) (sn, cn, dn float64) {
+	const maxIter = 16
+
+	var a, c [maxIter + 1]float64
+	a[0], c[0] = 1, math.Sqrt(m)
+	b := math.Sqrt(mc)
+
+	n := 0
+	for ; n < maxIter && math.Abs(c[n]) > 1e-16; n++ {
+		a[n+1] = (a[n] + b) / 2
+		c[n+1] = (a[n] - b) / 2
+		b = math.Sqrt(a[n] * b)
+	}
+
+	phi := math.Ldexp(a[n]*u, n)
+	prev := phi
+	for ; n > 0; n-- {
+		prev = phi
+		phi = (phi + math.Asin(c[n]/a[n]*math.Sin(phi))) / 2
+	}
+
+	sn, cn = math.Sincos(phi)
+	dn = 1
+	if prev != phi {
+		dn = cn / math.Cos(prev-phi)
+	}
+	return
+}
+
+// nomeParam returns the parameter m and its complement 1-m corresponding to
+// the nome q, computed from theta functions to avoid cancellation when m is
+// close to 1.
+func nomeParam(q float64) (m, mc float64) {
+	t2, t3, t4 := 0.0, 1.0, 1.0
+	for k := 0; k < 64; k++ {
+		h := float64(k) + 0.5
+		d2 := 2 * math.Pow(q, h*h)
+		t2 += d2
+
+		if k > 0 {
+			d3 := 2 * math.Pow(q, float64(k*k))
+			t3 += d3
+			if k%2 == 1 {
+				t4 -= d3
+			} else {
+				t4 += d3
+			}
+		}
+
+		if d2 < 1e-17 {
+			break
+		}
+	}
+
+	m = math.Pow(t2/t3, 4)
+	mc = math.Pow(t4/t3, 4)
+	return
+}
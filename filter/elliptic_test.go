@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// stopbandEdge returns the lowest (for Lowpass) or highest (for Highpass)
+// normalized frequency at which the gain of s first reaches -atten dB.
+func stopbandEdge(s SOS, atten float64, kind FilterKind) float64 {
+	const n = 20000
+	for i := 0; i <= n; i++ {
+		w := float64(i) / n
+		if kind == Highpass {
+			w = 1 - w
+		}
+		if 20*math.Log10(cmplx.Abs(s.Response(w))) <= -atten {
+			return w
+		}
+	}
+	return math.NaN()
+}
+
+type ellipticTest struct {
+	order      int
+	rp, rs, wc float64
+	kind       FilterKind
+}
+
+var ellipticTests = []ellipticTest{
+	{1, 1, 40, 0.3, Lowpass},
+	{2, 0.5, 30, 0.3, Lowpass},
+	{4, 1, 60, 0.2, Lowpass},
+	{5, 0.1, 80, 0.45, Lowpass},
+	{3, 1, 50, 0.5, Highpass},
+	{6, 0.5, 70, 0.3, Highpass},
+}
+
+func TestElliptic(t *testing.T) {
+	for _, et := range ellipticTests {
+		s := Elliptic(et.order, et.rp, et.rs, et.wc, et.kind)
+		edge := stopbandEdge(s, et.rs, et.kind)
+
+		plo, phi, slo, shi := 0.0, et.wc, edge, 1.0
+		if et.kind == Highpass {
+			plo, phi, slo, shi = et.wc, 1, 0, edge
+		}
+
+		mn, mx := gainRange(s, plo, phi)
+		if mx > 1e-9 || math.Abs(mn+et.rp) > 1e-6 {
+			t.Error("Elliptic passband ripple error\ninput:", et, "\noutput:", mn, mx, "\nexpected:", -et.rp, 0)
+		}
+
+		if et.order == 1 {
+			continue
+		}
+
+		_, mx = gainRange(s, slo, shi)
+		if mx > -et.rs+1e-6 || mx < -et.rs-1e-3 {
+			t.Error("Elliptic stopband attenuation error\ninput:", et, "\noutput:", mx, "\nexpected:", -et.rs)
+		}
+
+		// The transition band must be narrower than a Chebyshev type I filter
+		// with the same order and passband ripple.
+		c := ChebyshevI(et.order, et.rp, et.wc, et.kind)
+		if ce := stopbandEdge(c, et.rs, et.kind); math.Abs(ce-et.wc) <= math.Abs(edge-et.wc) {
+			t.Error("Elliptic transition width error\ninput:", et, "\noutput:", math.Abs(edge-et.wc), "\nchebyshev:", math.Abs(ce-et.wc))
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package filter provides digital filter design functions.
+package filter
+
+import (
+	"math"
+)
+
+// Bilinear returns the digital filter equivalent to the analog filter with
+// transfer function coefficients bAnalog and aAnalog (in descending powers of
+// s) using the bilinear transform s = 2*fs*(z-1)/(z+1), where fs is the
+// sampling frequency. The returned coefficients are in descending powers of z
+// (equivalently, ascending powers of z^-1), normalized so aDigital[0] is 1.
+// Reference: http://docs.scipy.org/doc/scipy/reference/generated/scipy.signal.bilinear.html
+func Bilinear(bAnalog, aAnalog []float64, fs float64) (bDigital, aDigital []float64) {
+	if len(aAnalog) == 0 || len(bAnalog) == 0 {
+		panic("empty coefficients")
+	}
+
+	d := max(len(bAnalog), len(aAnalog)) - 1
+	bDigital = bilinearPoly(bAnalog, d, 2*fs)
+	aDigital = bilinearPoly(aAnalog, d, 2*fs)
+
+	a0 := aDigital[0]
+	if a0 == 0 {
+		panic("invalid denominator")
+	}
+	for i := range aDigital {
+		aDigital[i] /= a0
+	}
+	for i := range bDigital {
+		bDigital[i] /= a0
+	}
+
+	return
+}
+
+// BilinearPrewarp is like Bilinear, but pre-warps the frequency scale so the
+// analog and digital responses match exactly at the frequency fp (in the
+// same units as fs) instead of only at DC.
+func BilinearPrewarp(bAnalog, aAnalog []float64, fs, fp float64) (bDigital, aDigital []float64) {
+	if fp <= 0 || fp >= fs/2 {
+		panic("pre-warp frequency out of range")
+	}
+
+	// Scaling 2*fs to 2*pi*fp/tan(pi*fp/fs) maps analog frequency fp to
+	// digital frequency fp.
+	k := math.Pi * fp / math.Tan(math.Pi*fp/fs)
+	return Bilinear(bAnalog, aAnalog, k)
+}
+
+// bilinearPoly returns the z-domain polynomial of degree d (in descending
+// powers of z) obtained by substituting s = k*(z-1)/(z+1) in p and
+// multiplying through by (z+1)^d.
+func bilinearPoly(p []float64, d int, k float64) []float64 {
+	r := make([]float64, d+1)
+	lp := len(p)
+
+	for i, c := range p {
+		// c is the coefficient of s^j
+		j := lp - 1 - i
+		t := []float64{c * math.Pow(k, float64(j))}
+		for range j {
+			t = polyMul(t, []float64{1, -1})
+		}
+		for range d - j {
+			t = polyMul(t, []float64{1, 1})
+		}
+
+		for n, v := range t {
+			r[n] += v
+		}
+	}
+
+	return r
+}
+
+// polyMul returns the product of the polynomials a and b.
+func polyMul(a, b []float64) []float64 {
+	r := make([]float64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			r[i+j] += x * y
+		}
+	}
+	return r
+}
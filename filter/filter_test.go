@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// response returns the frequency response of the digital filter b, a at
+// frequency f with sampling frequency fs.
+func response(b, a []float64, f, fs float64) complex128 {
+	z := cmplx.Exp(complex(0, -2*math.Pi*f/fs))
+	var num, den complex128
+	for i := len(b) - 1; i >= 0; i-- {
+		num = num*z + complex(b[i], 0)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		den = den*z + complex(a[i], 0)
+	}
+	return num / den
+}
+
+type bilinearTest struct {
+	b, a   []float64
+	fs     float64
+	bd, ad []float64
+}
+
+var bilinearTests = []bilinearTest{
+	{
+		[]float64{1}, []float64{1, 1}, 0.5,
+		[]float64{0.5, 0.5}, []float64{1, 0},
+	},
+	{
+		[]float64{1, 0}, []float64{1, 1}, 0.5,
+		[]float64{0.5, -0.5}, []float64{1, 0},
+	},
+	{
+		[]float64{1}, []float64{1, 2, 1}, 1,
+		[]float64{1.0 / 9, 2.0 / 9, 1.0 / 9}, []float64{1, -6.0 / 9, 1.0 / 9},
+	},
+}
+
+func TestBilinear(t *testing.T) {
+	for _, bt := range bilinearTests {
+		b, a := Bilinear(bt.b, bt.a, bt.fs)
+		if !dsputils.PrettyClose(b, bt.bd) || !dsputils.PrettyClose(a, bt.ad) {
+			t.Error("Bilinear error\ninput:", bt.b, bt.a, bt.fs, "\noutput:", b, a, "\nexpected:", bt.bd, bt.ad)
+		}
+	}
+}
+
+func TestBilinearPrewarp(t *testing.T) {
+	const (
+		fs = 1000.0
+		fc = 200.0
+	)
+
+	// Second-order analog Butterworth lowpass with cutoff fc.
+	wc := 2 * math.Pi * fc
+	b, a := BilinearPrewarp([]float64{wc * wc}, []float64{1, math.Sqrt2 * wc, wc * wc}, fs, fc)
+
+	if g := cmplx.Abs(response(b, a, 0, fs)); !dsputils.Float64Equal(g, 1) {
+		t.Error("BilinearPrewarp DC gain error\noutput:", g, "\nexpected:", 1)
+	}
+	if g := 20 * math.Log10(cmplx.Abs(response(b, a, fc, fs))); math.Abs(g+3.0103) > 1e-3 {
+		t.Error("BilinearPrewarp cutoff gain error\noutput:", g, "\nexpected:", -3.0103)
+	}
+	if g := cmplx.Abs(response(b, a, fs/2, fs)); g > 1e-12 {
+		t.Error("BilinearPrewarp Nyquist gain error\noutput:", g, "\nexpected:", 0)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+// Padding is a method of extending a signal past its ends before it is
+// filtered by FiltFilt, to reduce the transients at its edges.
+type Padding int
+
+const (
+	// Odd extends the signal by point reflection about its end points
+	// (2*x[0]-x[i] before the start), which continues its slope. This is
+	// SciPy's default.
+	Odd Padding = iota
+	// Even extends the signal by mirror reflection about its end points.
+	Even
+	// Constant extends the signal with copies of its end points.
+	Constant
+	// Zero extends the signal with zeros.
+	Zero
+)
+
+type FiltFiltOptions struct {
+	// Padding is how the signal is extended before filtering.
+	//
+	// The default value is Odd.
+	Padding Padding
+
+	// PadLen is the number of samples by which the signal is extended at each
+	// end. It must be less than the length of the signal. If it is negative,
+	// the signal is not extended.
+	//
+	// The default value is 0, which uses 3*(2*len(s)+1), less the number of
+	// sections with a zero second-order coefficient, like SciPy's
+	// sosfiltfilt.
+	PadLen int
+}
+
+// FiltFilt returns x filtered by s forward and then backward, which gives
+// zero phase distortion and squares the magnitude response. The signal is
+// extended at each end as specified by o, and each pass starts in the steady
+// state for its first sample, as SciPy's sosfiltfilt does.
+// It panics if the padding is not shorter than x.
+func (s SOS) FiltFilt(x []float64, o *FiltFiltOptions) []float64 {
+	padLen := o.PadLen
+	if padLen == 0 {
+		var zb, za int
+		for _, q := range s {
+			if q.B[2] == 0 {
+				zb++
+			}
+			if q.A[2] == 0 {
+				za++
+			}
+		}
+		padLen = 3 * (2*len(s) + 1 - min(zb, za))
+	}
+	padLen = max(padLen, 0)
+
+	n := len(x)
+	if n == 0 {
+		return []float64{}
+	}
+	if padLen >= n {
+		panic("signal shorter than padding")
+	}
+
+	ext := extend(x, padLen, o.Padding)
+
+	y := s.filter(ext, ext[0])
+	reverse(y)
+	y = s.filter(y, y[0])
+	reverse(y)
+
+	return y[padLen : padLen+n]
+}
+
+// extend returns x extended by padLen samples at each end with the method p.
+func extend(x []float64, padLen int, p Padding) []float64 {
+	n := len(x)
+	r := make([]float64, n+2*padLen)
+	copy(r[padLen:], x)
+
+	first, last := x[0], x[n-1]
+	for i := 1; i <= padLen; i++ {
+		var a, b float64 // before the start and after the end
+		switch p {
+		case Odd:
+			a, b = 2*first-x[i], 2*last-x[n-1-i]
+		case Even:
+			a, b = x[i], x[n-1-i]
+		case Constant:
+			a, b = first, last
+		case Zero:
+		default:
+			panic("unknown padding")
+		}
+		r[padLen-i] = a
+		r[padLen+n-1+i] = b
+	}
+
+	return r
+}
+
+func reverse(x []float64) {
+	for i, j := 0, len(x)-1; i < j; i, j = i+1, j-1 {
+		x[i], x[j] = x[j], x[i]
+	}
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFiltFilt(t *testing.T) {
+	s := ChebyshevII(4, 40, 0.2, Lowpass)
+	const n = 200
+
+	// a ramp has an odd extension that is the same line, so odd padding
+	// leaves it unchanged at the edges once the start-up transient has died
+	// out within the padding
+	ramp := make([]float64, n)
+	for i := range ramp {
+		ramp[i] = 1 + 0.01*float64(i)
+	}
+
+	edges := map[Padding]float64{}
+	for _, p := range []Padding{Odd, Even, Constant, Zero} {
+		y := s.FiltFilt(ramp, &FiltFiltOptions{Padding: p, PadLen: 100})
+		if len(y) != n {
+			t.Error("FiltFilt length error\noutput:", len(y), "\nexpected:", n)
+			continue
+		}
+
+		// away from the edges the padding makes no difference
+		for i := 80; i < 120; i++ {
+			if math.Abs(y[i]-ramp[i]) > 1e-3 {
+				t.Error("FiltFilt error\npadding:", p, "\nindex:", i, "\noutput:", y[i], "\nexpected:", ramp[i])
+				break
+			}
+		}
+		edges[p] = math.Abs(y[0]-ramp[0]) + math.Abs(y[n-1]-ramp[n-1])
+	}
+
+	if edges[Odd] > 1e-3 {
+		t.Error("FiltFilt odd padding edge error\noutput:", edges[Odd])
+	}
+	for _, p := range []Padding{Even, Constant, Zero} {
+		if edges[p] < 10*edges[Odd] {
+			t.Error("FiltFilt padding edge error\npadding:", p, "\noutput:", edges[p], "\nodd:", edges[Odd])
+		}
+	}
+
+	// zero phase: a passband sinusoid is not delayed
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * 0.02 * float64(i))
+	}
+	y := s.FiltFilt(x, &FiltFiltOptions{PadLen: 50})
+	for i := 50; i < 150; i++ {
+		if math.Abs(y[i]-x[i]) > 1e-2 {
+			t.Error("FiltFilt phase error\nindex:", i, "\noutput:", y[i], "\nexpected:", x[i])
+			break
+		}
+	}
+
+	if y := s.FiltFilt(x, &FiltFiltOptions{PadLen: -1}); len(y) != n {
+		t.Error("FiltFilt no padding length error\noutput:", len(y), "\nexpected:", n)
+	}
+}
+
+func TestFilterSteadyState(t *testing.T) {
+	s := ChebyshevI(3, 1, 0.3, Lowpass)
+	x := []float64{2, 2, 2, 2, 2}
+	g := real(s.Response(0))
+	for i, v := range s.filter(x, 2) {
+		if math.Abs(v-2*g) > 1e-12 {
+			t.Error("filter steady state error\nindex:", i, "\noutput:", v, "\nexpected:", 2*g)
+		}
+	}
+}
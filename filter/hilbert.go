@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// HilbertFIR returns the numTaps coefficients of an FIR Hilbert transformer,
+// which shifts the phase of every frequency by -90 degrees (a cosine becomes a
+// sine) with a delay of (numTaps-1)/2 samples. The taps are the ideal
+// response, 2/(pi*m) at odd offsets m from the center and 0 at even ones,
+// windowed by a Blackman window, so they are odd-symmetric: type III for odd
+// numTaps and type IV for even numTaps. The magnitude is close to 1 except
+// within about 6*pi/numTaps radians per sample of DC and, for odd numTaps, of
+// Nyquist, where it falls to 0. Even numTaps give a wider band, but a delay
+// that is not a whole number of samples.
+func HilbertFIR(numTaps int) []float64 {
+	if numTaps < 2 {
+		panic("invalid number of taps")
+	}
+
+	h := window.Blackman(numTaps)
+	c := float64(numTaps-1) / 2
+	for i := range h {
+		m := float64(i) - c
+		if m == 0 {
+			h[i] = 0
+			continue
+		}
+		h[i] *= (1 - math.Cos(math.Pi*m)) / (math.Pi * m)
+	}
+
+	return h
+}
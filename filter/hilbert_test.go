@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHilbertFIR(t *testing.T) {
+	for _, taps := range []int{101, 100} {
+		h := HilbertFIR(taps)
+		for i := range h {
+			if math.Abs(h[i]+h[taps-1-i]) > 1e-15 {
+				t.Error("HilbertFIR symmetry error\ntaps:", taps, "\nindex:", i, "\noutput:", h[i], h[taps-1-i])
+				break
+			}
+		}
+
+		d := float64(taps-1) / 2
+		for _, w := range []float64{0.15, 0.3, 0.5, 0.7, 0.85} {
+			w *= math.Pi
+			// the response to a cosine, past the start-up transient, is a
+			// sine delayed by d
+			for n := taps; n < taps+50; n++ {
+				var y float64
+				for k, v := range h {
+					y += v * math.Cos(w*float64(n-k))
+				}
+				if e := math.Sin(w * (float64(n) - d)); math.Abs(y-e) > 0.01 {
+					t.Error("HilbertFIR error\ntaps:", taps, "\nfrequency:", w, "\noutput:", y, "\nexpected:", e)
+					break
+				}
+			}
+		}
+	}
+}
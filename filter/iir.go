@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+)
+
+// FilterKind specifies the type of frequency response of a filter.
+type FilterKind int
+
+const (
+	Lowpass FilterKind = iota
+	Highpass
+)
+
+// Biquad is a second-order section of an IIR filter. B and A are the
+// numerator and denominator coefficients in ascending powers of z^-1.
+type Biquad struct {
+	B, A [3]float64
+}
+
+// SOS is an IIR filter represented as a cascade of second-order sections.
+type SOS []Biquad
+
+// Response returns the frequency response of s at the normalized frequency
+// w, where 1 is the Nyquist frequency.
+func (s SOS) Response(w float64) complex128 {
+	z := cmplx.Exp(complex(0, -math.Pi*w))
+	z2 := z * z
+	r := complex(1, 0)
+	for _, q := range s {
+		num := complex(q.B[0], 0) + complex(q.B[1], 0)*z + complex(q.B[2], 0)*z2
+		den := complex(q.A[0], 0) + complex(q.A[1], 0)*z + complex(q.A[2], 0)*z2
+		r *= num / den
+	}
+	return r
+}
+
+// Filter returns x filtered by s, with zero initial conditions.
+func (s SOS) Filter(x []float64) []float64 {
+	return s.filter(x, 0)
+}
+
+// filter returns x filtered by s, with the initial conditions of the steady
+// state for a constant input of u, so that if x starts at u there is no
+// start-up transient (like SciPy's sosfilt with sosfilt_zi scaled by u).
+func (s SOS) filter(x []float64, u float64) []float64 {
+	r := make([]float64, len(x))
+	copy(r, x)
+
+	for _, q := range s {
+		b0, b1, b2 := q.B[0]/q.A[0], q.B[1]/q.A[0], q.B[2]/q.A[0]
+		a1, a2 := q.A[1]/q.A[0], q.A[2]/q.A[0]
+
+		// steady state of the delays, and the input to the next section
+		var d1, d2 float64
+		if u != 0 {
+			y := u * (b0 + b1 + b2) / (1 + a1 + a2)
+			d2 = b2*u - a2*y
+			d1 = b1*u - a1*y + d2
+			u = y
+		}
+
+		// transposed direct form II
+		for i, v := range r {
+			y := b0*v + d1
+			d1 = b1*v - a1*y + d2
+			d2 = b2*v - a2*y
+			r[i] = y
+		}
+	}
+
+	return r
+}
+
+// zpk is a transfer function in zero-pole-gain form.
+type zpk struct {
+	z, p []complex128
+	k    float64
+}
+
+// transform returns the analog lowpass prototype f, with cutoff 1 rad/s,
+// transformed to a digital filter of kind with normalized cutoff frequency
+// (where 1 is the Nyquist frequency).
+func (f zpk) transform(cutoff float64, kind FilterKind) SOS {
+	if cutoff <= 0 || cutoff >= 1 {
+		panic("cutoff frequency out of range")
+	}
+
+	// pre-warp for a sampling frequency of 2
+	const fs2 = 4
+	wo := fs2 * math.Tan(math.Pi*cutoff/2)
+
+	switch kind {
+	case Lowpass:
+		f = f.lowpass(wo)
+	case Highpass:
+		f = f.highpass(wo)
+	default:
+		panic("unknown filter kind")
+	}
+
+	return f.bilinear(fs2).sos()
+}
+
+// lowpass returns f with its cutoff frequency scaled to wo.
+func (f zpk) lowpass(wo float64) zpk {
+	r := zpk{make([]complex128, len(f.z)), make([]complex128, len(f.p)), f.k}
+	for i, v := range f.z {
+		r.z[i] = v * complex(wo, 0)
+	}
+	for i, v := range f.p {
+		r.p[i] = v * complex(wo, 0)
+	}
+	r.k *= math.Pow(wo, float64(len(f.p)-len(f.z)))
+	return r
+}
+
+// highpass returns the highpass equivalent of f with cutoff frequency wo.
+func (f zpk) highpass(wo float64) zpk {
+	r := zpk{make([]complex128, len(f.p)), make([]complex128, len(f.p)), f.k}
+	for i, v := range f.z {
+		r.z[i] = complex(wo, 0) / v
+	}
+	// zeros at infinity move to the origin
+	for i := len(f.z); i < len(f.p); i++ {
+		r.z[i] = 0
+	}
+	for i, v := range f.p {
+		r.p[i] = complex(wo, 0) / v
+	}
+	r.k *= real(prod(neg(f.z)) / prod(neg(f.p)))
+	return r
+}
+
+// bilinear returns the digital equivalent of the analog filter f using the
+// bilinear transform with s = fs2*(z-1)/(z+1).
+func (f zpk) bilinear(fs2 float64) zpk {
+	c := complex(fs2, 0)
+	r := zpk{make([]complex128, len(f.p)), make([]complex128, len(f.p)), f.k}
+	for i, v := range f.z {
+		r.z[i] = (c + v) / (c - v)
+	}
+	// zeros at infinity move to the Nyquist frequency
+	for i := len(f.z); i < len(f.p); i++ {
+		r.z[i] = -1
+	}
+	for i, v := range f.p {
+		r.p[i] = (c + v) / (c - v)
+	}
+
+	num, den := complex(1, 0), complex(1, 0)
+	for _, v := range f.z {
+		num *= c - v
+	}
+	for _, v := range f.p {
+		den *= c - v
+	}
+	r.k *= real(num / den)
+	return r
+}
+
+// sos returns f, which must have as many zeros as poles, as second-order
+// sections. Poles closest to the unit circle are paired with their nearest
+// zeros, and the gain is applied to the first section.
+func (f zpk) sos() SOS {
+	pp := conjPairs(f.p)
+	zp := conjPairs(f.z)
+	if len(pp) != len(zp) {
+		panic("mismatched poles and zeros")
+	}
+
+	sort.Slice(pp, func(i, j int) bool {
+		return cmplx.Abs(pp[i][0]) > cmplx.Abs(pp[j][0])
+	})
+
+	used := make([]bool, len(zp))
+	r := make(SOS, len(pp))
+	for i, p := range pp {
+		// prefer the nearest zeros of the same order as the poles
+		best := -1
+		for j, z := range zp {
+			if used[j] {
+				continue
+			}
+			if best < 0 || len(z) == len(p) && len(zp[best]) != len(p) ||
+				len(z) == len(zp[best]) && cmplx.Abs(z[0]-p[0]) < cmplx.Abs(zp[best][0]-p[0]) {
+				best = j
+			}
+		}
+		used[best] = true
+
+		r[i] = Biquad{polyPair(zp[best]), polyPair(p)}
+	}
+
+	for n := range r[0].B {
+		r[0].B[n] *= f.k
+	}
+
+	return r
+}
+
+// conjPairs groups x into complex conjugate pairs, with any real values
+// paired with each other. A trailing unpaired real value is returned alone.
+func conjPairs(x []complex128) [][]complex128 {
+	const tol = 1e-10
+
+	var reals []complex128
+	var cplx []complex128
+	for _, v := range x {
+		if math.Abs(imag(v)) <= tol*math.Max(1, cmplx.Abs(v)) {
+			reals = append(reals, complex(real(v), 0))
+		} else if imag(v) > 0 {
+			cplx = append(cplx, v)
+		}
+	}
+
+	var r [][]complex128
+	for _, v := range cplx {
+		r = append(r, []complex128{v, cmplx.Conj(v)})
+	}
+
+	sort.Slice(reals, func(i, j int) bool {
+		return real(reals[i]) < real(reals[j])
+	})
+	for i := 0; i < len(reals); i += 2 {
+		if i+1 < len(reals) {
+			r = append(r, []complex128{reals[i], reals[i+1]})
+		} else {
+			r = append(r, []complex128{reals[i]})
+		}
+	}
+
+	return r
+}
+
+// polyPair returns the coefficients of the polynomial in z^-1 with roots x,
+// which has one or two elements.
+func polyPair(x []complex128) [3]float64 {
+	if len(x) == 1 {
+		return [3]float64{1, -real(x[0]), 0}
+	}
+
+	return [3]float64{1, -real(x[0] + x[1]), real(x[0] * x[1])}
+}
+
+func prod(x []complex128) complex128 {
+	r := complex(1, 0)
+	for _, v := range x {
+		r *= v
+	}
+	return r
+}
+
+func neg(x []complex128) []complex128 {
+	r := make([]complex128, len(x))
+	for i, v := range x {
+		r[i] = -v
+	}
+	return r
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+// StreamFIR filters a stream of blocks with a fixed FIR filter using FFT
+// overlap-add. The tail of each block's convolution is carried over to the
+// next call to Process, so the output is the same as filtering the whole
+// stream at once, with no added delay. The working buffers are allocated by
+// NewStreamFIR, and the transforms are power of 2 FFTs computed with
+// fft.FFTInto, so Process normally allocates nothing when they run on a
+// single worker, as with fft.SetWorkerPoolSize(1); see fft.FFTInto for when
+// its scratch buffers are reallocated. With parallel transforms, it also
+// allocates the FFT workers.
+// A StreamFIR is not safe for concurrent use; use one per goroutine.
+type StreamFIR struct {
+	block int
+	hf    []complex128 // FFT of the zero-padded coefficients
+	buf   []complex128 // FFT work buffer
+	acc   []float64    // convolution of the current block
+	tail  []float64    // len(coeffs)-1 samples carried to the next block
+}
+
+// NewStreamFIR returns a StreamFIR for the filter coefficients coeffs, which
+// transforms the input in blocks of up to blockSize samples. The FFT length is
+// the power of 2 at least blockSize+len(coeffs)-1.
+func NewStreamFIR(coeffs []float64, blockSize int) *StreamFIR {
+	if len(coeffs) == 0 {
+		panic("empty coefficients")
+	}
+	if blockSize < 1 {
+		panic("invalid block size")
+	}
+
+	nfft := dsputils.NextPowerOf2(blockSize + len(coeffs) - 1)
+	hf := make([]complex128, nfft)
+	for i, v := range coeffs {
+		hf[i] = complex(v, 0)
+	}
+	fft.FFTInto(hf, hf)
+
+	return &StreamFIR{
+		block: blockSize,
+		hf:    hf,
+		buf:   make([]complex128, nfft),
+		acc:   make([]float64, blockSize+len(coeffs)-1),
+		tail:  make([]float64, len(coeffs)-1),
+	}
+}
+
+// Process stores the filtered samples of src, the next block of the stream,
+// in dst. src may have any length, and dst must have the same length; dst may
+// be src.
+func (s *StreamFIR) Process(dst, src []float64) {
+	if len(dst) != len(src) {
+		panic("arrays not of equal size")
+	}
+
+	for start := 0; start < len(src); start += s.block {
+		end := min(start+s.block, len(src))
+		n := end - start
+
+		for i := range s.buf {
+			s.buf[i] = 0
+		}
+		for i, v := range src[start:end] {
+			s.buf[i] = complex(v, 0)
+		}
+		fft.FFTInto(s.buf, s.buf)
+		for i, v := range s.hf {
+			s.buf[i] *= v
+		}
+		fft.IFFTInto(s.buf, s.buf)
+
+		acc := s.acc[:n+len(s.tail)]
+		for i := range acc {
+			acc[i] = real(s.buf[i])
+		}
+		for i, v := range s.tail {
+			acc[i] += v
+		}
+		copy(dst[start:end], acc)
+		copy(s.tail, acc[n:])
+	}
+}
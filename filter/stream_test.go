@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package filter
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+func TestStreamFIR(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	h := make([]float64, 37)
+	for i := range h {
+		h[i] = rnd.NormFloat64()
+	}
+	x := make([]float64, 3000)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	e := dsputils.Convolve(x, h, dsputils.Full)[:len(x)]
+
+	for _, block := range []int{1, 64, 100} {
+		s := NewStreamFIR(h, block)
+		y := make([]float64, len(x))
+		// blocks shorter than the filter, and longer than blockSize
+		for start, n := 0, 1; start < len(x); start, n = start+n, n*3%250+1 {
+			end := min(start+n, len(x))
+			s.Process(y[start:end], x[start:end])
+		}
+		for i := range y {
+			if math.Abs(y[i]-e[i]) > 1e-9 {
+				t.Error("StreamFIR error\nblock:", block, "\nindex:", i, "\noutput:", y[i], "\nexpected:", e[i])
+				break
+			}
+		}
+	}
+
+	// in place
+	s := NewStreamFIR(h, 64)
+	y := append([]float64(nil), x...)
+	s.Process(y, y)
+	if !dsputils.PrettyClose(y, e) {
+		t.Error("StreamFIR in place error")
+	}
+}
+
+func TestStreamFIRAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocations are not reproducible with the race detector")
+	}
+
+	// the built-in engine does not allocate on a single worker
+	fft.SetWorkerPoolSize(1)
+	defer fft.SetWorkerPoolSize(0)
+
+	s := NewStreamFIR([]float64{0.25, 0.5, 0.25}, 32)
+	x := make([]float64, 32)
+	y := make([]float64, 32)
+	s.Process(y, x) // warm up
+
+	if n := testing.AllocsPerRun(10, func() { s.Process(y, x) }); n != 0 {
+		t.Error("StreamFIR allocation error\noutput:", n, "\nexpected:", 0)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// Burg estimates the coefficients of an order-order autoregressive (AR)
+// model of x using Burg's method. coeffs has length order+1 and holds the
+// coefficients of the prediction error filter A(z) = 1 + a1*z^-1 + ... +
+// ap*z^-p, with coeffs[0] = 1; variance is the variance of the white noise
+// driving the model.
+// Reference: http://www.mathworks.com/help/signal/ref/arburg.html
+func Burg(x []float64, order int) (coeffs []float64, variance float64) {
+	n := len(x)
+	if order < 0 || order >= n {
+		panic("invalid model order")
+	}
+
+	f := make([]float64, n)
+	b := make([]float64, n)
+	copy(f, x)
+	copy(b, x)
+
+	for _, v := range x {
+		variance += v * v
+	}
+	variance /= float64(n)
+
+	coeffs = []float64{1}
+	for m := 1; m <= order; m++ {
+		var num, den float64
+		for i := m; i < n; i++ {
+			num += f[i] * b[i-1]
+			den += f[i]*f[i] + b[i-1]*b[i-1]
+		}
+		k := -2 * num / den
+
+		// Update the forward and backward prediction errors in place,
+		// descending so b[i-1] is read before it is overwritten.
+		for i := n - 1; i >= m; i-- {
+			fi := f[i]
+			f[i] = fi + k*b[i-1]
+			b[i] = b[i-1] + k*fi
+		}
+
+		coeffs = levinsonStep(coeffs, k)
+		variance *= 1 - k*k
+	}
+
+	return
+}
+
+// levinsonStep returns the order-(len(a)) prediction error filter obtained
+// from a with the reflection coefficient k.
+func levinsonStep(a []float64, k float64) []float64 {
+	r := make([]float64, len(a)+1)
+	copy(r, a)
+	for i := 1; i < len(r); i++ {
+		r[i] += k * a[len(a)-i]
+	}
+	return r
+}
+
+// ARSpectrum returns the power spectral density of the autoregressive model
+// with prediction error filter coeffs (as returned by Burg) and white noise
+// variance, evaluated at nFreqs frequencies evenly spaced from 0 to the
+// Nyquist frequency, inclusive. The returned values are variance/|A(f)|^2.
+func ARSpectrum(coeffs []float64, variance float64, nFreqs int) []float64 {
+	r := make([]float64, nFreqs)
+	for i := range r {
+		var w float64
+		if nFreqs > 1 {
+			w = math.Pi * float64(i) / float64(nFreqs-1)
+		}
+
+		var a complex128
+		for k, c := range coeffs {
+			a += complex(c, 0) * cmplx.Exp(complex(0, -w*float64(k)))
+		}
+
+		r[i] = variance / real(a*cmplx.Conj(a))
+	}
+
+	return r
+}
+
+// YuleWalker estimates the coefficients of an order-order autoregressive
+// model of x by solving the Yule-Walker equations for the biased
+// autocorrelation of x. The results are in the same form as Burg.
+// Reference: http://www.mathworks.com/help/signal/ref/aryule.html
+func YuleWalker(x []float64, order int) (coeffs []float64, variance float64) {
+	n := len(x)
+	if order < 0 || order >= n {
+		panic("invalid model order")
+	}
+
+	r := make([]float64, order+1)
+	for k := range r {
+		for i := 0; i+k < n; i++ {
+			r[k] += x[i] * x[i+k]
+		}
+		r[k] /= float64(n)
+	}
+
+	return dsputils.Levinson(r)
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// resolved returns true if p has two peaks near bins i < j separated by a
+// dip of at least 3 dB relative to both of them.
+func resolved(p []float64, i, j int) bool {
+	d := (j - i) / 2
+	argmax := func(lo, hi int) int {
+		m := lo
+		for k := lo; k < hi; k++ {
+			if p[k] > p[m] {
+				m = k
+			}
+		}
+		return m
+	}
+
+	p1, p2 := argmax(i-d, i+d), argmax(j-d, j+d)
+	dip := math.Inf(1)
+	for k := p1; k <= p2; k++ {
+		dip = math.Min(dip, p[k])
+	}
+	return dip < math.Min(p[p1], p[p2])/2
+}
+
+func TestBurg(t *testing.T) {
+	const (
+		n      = 48
+		f1, f2 = 0.200, 0.210 // cycles per sample
+		nFreqs = 2049
+	)
+
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(2*math.Pi*f1*float64(i)) + math.Sin(2*math.Pi*f2*float64(i)+1) + 0.01*rnd.NormFloat64()
+	}
+
+	coeffs, variance := Burg(x, 20)
+	if len(coeffs) != 21 || coeffs[0] != 1 || variance <= 0 {
+		t.Fatal("Burg error\ncoeffs:", coeffs, "\nvariance:", variance)
+	}
+
+	// bins of nFreqs covering 0 to 0.5 cycles per sample
+	bin := func(f float64) int { return int(f * 2 * (nFreqs - 1)) }
+	b1, b2 := bin(f1), bin(f2)
+
+	ar := ARSpectrum(coeffs, variance, nFreqs)
+	if !resolved(ar, b1, b2) {
+		t.Error("Burg resolution error\noutput:", ar[b1], ar[(b1+b2)/2], ar[b2])
+	}
+
+	p, _ := Pwelch(x, 1, &PwelchOptions{NFFT: n, Pad: 2 * (nFreqs - 1), Window: window.Rectangular})
+	if resolved(p, b1, b2) {
+		t.Error("periodogram unexpectedly resolved peaks\noutput:", p[b1], p[(b1+b2)/2], p[b2])
+	}
+}
+
+func TestARSpectrum(t *testing.T) {
+	// white noise has a flat spectrum
+	for _, v := range ARSpectrum([]float64{1}, 2, 5) {
+		if v != 2 {
+			t.Error("ARSpectrum error\noutput:", v, "\nexpected:", 2)
+		}
+	}
+
+	// A(z) = 1 - 0.5z^-1: |A|^2 is 0.25 at DC and 2.25 at Nyquist
+	p := ARSpectrum([]float64{1, -0.5}, 1, 3)
+	if math.Abs(p[0]-4) > 1e-12 || math.Abs(p[2]-1/2.25) > 1e-12 {
+		t.Error("ARSpectrum error\noutput:", p, "\nexpected:", 4, 1/2.25)
+	}
+}
+
+func TestYuleWalker(t *testing.T) {
+	// x[n] = 0.75x[n-1] - 0.5x[n-2] + e[n], so A(z) = 1 - 0.75z^-1 + 0.5z^-2.
+	want := []float64{1, -0.75, 0.5}
+
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 20000)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		if i >= 2 {
+			x[i] -= want[1]*x[i-1] + want[2]*x[i-2]
+		}
+	}
+
+	for _, f := range []func([]float64, int) ([]float64, float64){YuleWalker, Burg} {
+		coeffs, variance := f(x, 2)
+		for i, c := range coeffs {
+			if math.Abs(c-want[i]) > 0.03 {
+				t.Error("AR coefficient error\noutput:", coeffs, "\nexpected:", want)
+				break
+			}
+		}
+		if math.Abs(variance-1) > 0.05 {
+			t.Error("AR variance error\noutput:", variance, "\nexpected:", 1)
+		}
+	}
+
+	// An order-0 model is the variance of x.
+	coeffs, variance := YuleWalker([]float64{1, -1, 1, -1}, 0)
+	if !dsputils.PrettyClose(coeffs, []float64{1}) || variance != 1 {
+		t.Error("YuleWalker error\noutput:", coeffs, variance, "\nexpected:", []float64{1}, 1)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+type ChromaOptions struct {
+	// NFFT is the length of each frame. With the default Hop, it must be at
+	// least 4.
+	//
+	// The default value is 4096.
+	NFFT int
+
+	// Hop is the number of samples between the starts of consecutive frames.
+	//
+	// The default value is 0, which uses NFFT/4.
+	Hop int
+
+	// Tuning is the frequency in Hz of the A above middle C.
+	//
+	// The default value is 0, which uses 440.
+	Tuning float64
+}
+
+// Chroma returns the chroma features of x, which has sampling frequency fs.
+// For each frame, the energy of every frequency bin from 27.5 Hz (A0) up is
+// added to the pitch class of its nearest equal-tempered note. Element
+// [m][c] is the energy of pitch class c (0 is C, 1 is C#, ..., 11 is B) in
+// frame m, normalized so the largest class in each frame is 1.
+func Chroma(x []float64, fs float64, o *ChromaOptions) [][]float64 {
+	nfft := o.NFFT
+	hop := o.Hop
+	tuning := o.Tuning
+
+	if nfft == 0 {
+		nfft = 4096
+	}
+	if hop == 0 {
+		hop = nfft / 4
+	}
+	if tuning == 0 {
+		tuning = 440
+	}
+	if nfft < 1 || hop < 1 {
+		panic("invalid frame length")
+	}
+
+	// pitch class of each bin, or -1 if it is below the lowest note
+	class := make([]int, nfft/2+1)
+	for k := range class {
+		f := float64(k) * fs / float64(nfft)
+		if f < 27.5 {
+			class[k] = -1
+			continue
+		}
+		midi := int(math.Round(12*math.Log2(f/tuning) + 69))
+		class[k] = midi % 12
+	}
+
+	spec := stft(x, window.Hann(nfft), hop)
+	r := make([][]float64, len(spec))
+	for m, s := range spec {
+		r[m] = make([]float64, 12)
+		for k, v := range s {
+			if c := class[k]; c >= 0 {
+				a := cmplx.Abs(v)
+				r[m][c] += a * a
+			}
+		}
+
+		var peak float64
+		for _, v := range r[m] {
+			peak = max(peak, v)
+		}
+		if peak > 0 {
+			for c := range r[m] {
+				r[m][c] /= peak
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChroma(t *testing.T) {
+	const fs = 22050
+
+	// C major chord: C4, E4, G4
+	x := make([]float64, fs)
+	for _, f := range []float64{261.63, 329.63, 392.00} {
+		for i, v := range tone(len(x), f, fs) {
+			x[i] += v
+		}
+	}
+
+	for _, tuning := range []float64{0, 432} {
+		y := x
+		if tuning != 0 {
+			// the same chord tuned to A=432
+			y = make([]float64, len(x))
+			for _, f := range []float64{261.63, 329.63, 392.00} {
+				for i, v := range tone(len(y), f*tuning/440, fs) {
+					y[i] += v
+				}
+			}
+		}
+
+		c := Chroma(y, fs, &ChromaOptions{Tuning: tuning})
+		if len(c) == 0 {
+			t.Fatal("Chroma error: no frames")
+		}
+
+		mean := make([]float64, 12)
+		for _, frame := range c {
+			for i, v := range frame {
+				mean[i] += v / float64(len(c))
+			}
+		}
+
+		// C, E and G must each exceed every other pitch class
+		var others float64
+		for i, v := range mean {
+			if i != 0 && i != 4 && i != 7 {
+				others = math.Max(others, v)
+			}
+		}
+		for _, i := range []int{0, 4, 7} {
+			if mean[i] < 0.5 || mean[i] < 10*others {
+				t.Error("Chroma error\ntuning:", tuning, "\noutput:", mean, "\nexpected: energy in C, E and G")
+				break
+			}
+		}
+	}
+}
+
+func TestChromaShortFrame(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Chroma with NFFT 3 did not panic")
+		}
+	}()
+	Chroma(make([]float64, 100), 8000, &ChromaOptions{NFFT: 3})
+}
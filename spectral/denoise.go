@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+type SpectralSubtractOptions struct {
+	// NFFT is the length of each frame, which must be at least 4. Frames
+	// overlap by 75%.
+	//
+	// The default value is 512.
+	NFFT int
+
+	// NoiseFrames is the number of frames at the start of x used to estimate
+	// the noise profile when none is given. These frames should contain only
+	// noise.
+	//
+	// The default value is 10.
+	NoiseFrames int
+
+	// Alpha is the over-subtraction factor: Alpha times the noise magnitude is
+	// subtracted from each frame.
+	//
+	// The default value is 0, which uses 1.
+	Alpha float64
+
+	// Floor is the minimum magnitude of each bin after subtraction, as a
+	// fraction of its magnitude before subtraction. This avoids negative
+	// magnitudes and reduces "musical noise".
+	//
+	// The default value is 0.02.
+	Floor float64
+}
+
+// SpectralSubtract returns x with stationary noise reduced by spectral
+// subtraction. The noise magnitude in each bin is subtracted from the
+// magnitude of every frame of x, keeping its phase, and the result is
+// resynthesized by overlap-add.
+// noiseProfile is the mean magnitude of the noise in each of the NFFT/2+1
+// bins, as returned by NoiseProfile. If it is nil, it is estimated from the
+// first NoiseFrames frames of x; if x is then shorter than NFFT, there is no
+// full frame to estimate from and x is returned unchanged.
+// Reference: Boll, "Suppression of Acoustic Noise in Speech Using Spectral
+// Subtraction," 1979.
+func SpectralSubtract(x []float64, noiseProfile []float64, o *SpectralSubtractOptions) []float64 {
+	if len(x) == 0 {
+		return []float64{}
+	}
+
+	nfft := o.NFFT
+	frames := o.NoiseFrames
+	alpha := o.Alpha
+	floor := o.Floor
+
+	if nfft == 0 {
+		nfft = 512
+	}
+	if nfft < 4 {
+		panic("NFFT must be at least 4")
+	}
+	if frames == 0 {
+		frames = 10
+	}
+	if alpha == 0 {
+		alpha = 1
+	}
+	if floor == 0 {
+		floor = 0.02
+	}
+
+	if noiseProfile == nil {
+		if len(x) < nfft {
+			return append([]float64{}, x...)
+		}
+		noiseProfile = NoiseProfile(x[:min(len(x), (frames+3)*nfft/4)], nfft)
+	}
+	if len(noiseProfile) != nfft/2+1 {
+		panic("noise profile length must be NFFT/2+1")
+	}
+
+	// pad both ends so every sample is covered by full frames
+	w := window.HannPeriodic(nfft)
+	hop := nfft / 4
+	xp := make([]float64, len(x)+2*nfft)
+	copy(xp[nfft:], x)
+
+	spec := stft(xp, w, hop)
+	for _, s := range spec {
+		for k, v := range s {
+			mag := cmplx.Abs(v)
+			sub := math.Max(mag-alpha*noiseProfile[k], floor*mag)
+			if mag > 0 {
+				s[k] = v * complex(sub/mag, 0)
+			}
+		}
+	}
+
+	r := make([]float64, len(x))
+	copy(r, istft(spec, w, hop)[nfft:])
+	return r
+}
+
+// NoiseProfile returns the mean magnitude spectrum of the nfft-length frames
+// of noise, overlapping by 75%, for use with SpectralSubtract. nfft must be at
+// least 4, and noise at least nfft long.
+func NoiseProfile(noise []float64, nfft int) []float64 {
+	if nfft < 4 {
+		panic("NFFT must be at least 4")
+	}
+	w := window.HannPeriodic(nfft)
+	spec := stft(noise, w, nfft/4)
+	if len(spec) == 0 {
+		panic("noise shorter than NFFT")
+	}
+
+	r := make([]float64, nfft/2+1)
+	for _, s := range spec {
+		for k, v := range s {
+			r[k] += cmplx.Abs(v) / float64(len(spec))
+		}
+	}
+
+	return r
+}
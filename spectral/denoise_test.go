@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSpectralSubtract(t *testing.T) {
+	const (
+		fs    = 8000.0
+		f     = 1000.0
+		quiet = 2000 // samples of noise before the tone
+	)
+
+	rnd := rand.New(rand.NewSource(1))
+	clean := make([]float64, 16000)
+	noisy := make([]float64, len(clean))
+	for i := range clean {
+		if i >= quiet {
+			clean[i] = math.Sin(2 * math.Pi * f * float64(i) / fs)
+		}
+		noisy[i] = clean[i] + 0.3*rnd.NormFloat64()
+	}
+
+	// error energy relative to the clean signal, away from the edges
+	errEnergy := func(x []float64) float64 {
+		var e float64
+		for i := quiet + 1000; i < len(x)-1000; i++ {
+			d := x[i] - clean[i]
+			e += d * d
+		}
+		return e
+	}
+
+	estimated := SpectralSubtract(noisy, nil, &SpectralSubtractOptions{})
+	profile := NoiseProfile(noisy[:quiet], 512)
+	given := SpectralSubtract(noisy, profile, &SpectralSubtractOptions{})
+
+	before := errEnergy(noisy)
+	for _, y := range [][]float64{estimated, given} {
+		if len(y) != len(noisy) {
+			t.Fatal("SpectralSubtract length error\noutput:", len(y), "\nexpected:", len(noisy))
+		}
+
+		if after := errEnergy(y); after > before/4 {
+			t.Error("SpectralSubtract noise reduction error\nerror energy:", after, "\nbefore:", before)
+		}
+
+		// The tone must survive.
+		mid := y[8000 : 8000+2048]
+		if pf := peakFreq(mid, fs); math.Abs(pf-f) > fs/2048 {
+			t.Error("SpectralSubtract frequency error\noutput:", pf, "\nexpected:", f)
+		}
+		var rms float64
+		for _, v := range mid {
+			rms += v * v
+		}
+		if rms = math.Sqrt(rms / float64(len(mid))); math.Abs(rms-math.Sqrt2/2) > 0.05 {
+			t.Error("SpectralSubtract tone amplitude error\noutput:", rms, "\nexpected:", math.Sqrt2/2)
+		}
+	}
+}
+
+func TestSpectralSubtractShort(t *testing.T) {
+	x := []float64{1, -2, 3, -4, 5}
+	y := SpectralSubtract(x, nil, &SpectralSubtractOptions{NFFT: 8})
+	if !reflect.DeepEqual(y, x) {
+		t.Error("SpectralSubtract short input error\ninput:", x, "\noutput:", y, "\nexpected:", x)
+	}
+	y[0] = 0
+	if x[0] != 1 {
+		t.Error("SpectralSubtract short input aliases its input")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SpectralSubtract with NFFT 2 did not panic")
+		}
+	}()
+	SpectralSubtract(x, nil, &SpectralSubtractOptions{NFFT: 2})
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+)
+
+// Centroid returns the spectral centroid of the magnitude spectrum mag: the
+// magnitude-weighted mean of freqs. It returns 0 if mag sums to 0.
+func Centroid(mag, freqs []float64) float64 {
+	var s, sw float64
+	for i, m := range mag {
+		s += m
+		sw += m * freqs[i]
+	}
+	if s == 0 {
+		return 0
+	}
+	return sw / s
+}
+
+// Spread returns the spectral spread of the magnitude spectrum mag: the
+// magnitude-weighted standard deviation of freqs about the centroid.
+func Spread(mag, freqs []float64) float64 {
+	c := Centroid(mag, freqs)
+	var s, sw float64
+	for i, m := range mag {
+		d := freqs[i] - c
+		s += m
+		sw += m * d * d
+	}
+	if s == 0 {
+		return 0
+	}
+	return math.Sqrt(sw / s)
+}
+
+// Flatness returns the spectral flatness of mag: the ratio of its geometric
+// mean to its arithmetic mean. It is near 1 for noise-like spectra and near 0
+// for tonal ones.
+func Flatness(mag []float64) float64 {
+	if len(mag) == 0 {
+		return 0
+	}
+
+	var logs, s float64
+	for _, m := range mag {
+		if m == 0 {
+			return 0
+		}
+		logs += math.Log(m)
+		s += m
+	}
+
+	n := float64(len(mag))
+	return math.Exp(logs/n) / (s / n)
+}
+
+// Rolloff returns the frequency below which the fraction percent (for
+// example, 0.85) of the total magnitude of mag lies.
+func Rolloff(mag, freqs []float64, percent float64) float64 {
+	var total float64
+	for _, m := range mag {
+		total += m
+	}
+
+	var s float64
+	for i, m := range mag {
+		s += m
+		if s >= percent*total {
+			return freqs[i]
+		}
+	}
+	return freqs[len(freqs)-1]
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestSpectralFeatures(t *testing.T) {
+	const fs = 8000
+
+	x := tone(8192, 1000, fs)
+	p, freqs := Pwelch(x, fs, &PwelchOptions{NFFT: 1024})
+	mag := make([]float64, len(p))
+	for i, v := range p {
+		mag[i] = math.Sqrt(v)
+	}
+
+	if c := Centroid(mag, freqs); math.Abs(c-1000) > 10 {
+		t.Error("Centroid error\noutput:", c, "\nexpected:", 1000)
+	}
+	if s := Spread(mag, freqs); s > 50 {
+		t.Error("Spread error\noutput:", s, "\nexpected: < 50")
+	}
+	if f := Flatness(mag); f > 0.1 {
+		t.Error("Flatness tone error\noutput:", f, "\nexpected: < 0.1")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	noise := make([]float64, 1<<16)
+	for i := range noise {
+		noise[i] = r.NormFloat64()
+	}
+	p, _ = Pwelch(noise, fs, &PwelchOptions{NFFT: 256})
+	if f := Flatness(p[1 : len(p)-1]); f < 0.9 {
+		t.Error("Flatness noise error\noutput:", f, "\nexpected: > 0.9")
+	}
+
+	m := []float64{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	f := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	tests := []struct {
+		name string
+		out  float64
+		exp  float64
+	}{
+		{"Centroid", Centroid(m, f), 4.5},
+		{"Spread", Spread(m, f), math.Sqrt(8.25)},
+		{"Flatness", Flatness(m), 1},
+		{"Rolloff", Rolloff(m, f, 0.85), 8},
+	}
+	for _, v := range tests {
+		if !dsputils.Float64Equal(v.out, v.exp) {
+			t.Error(v.name, "error\noutput:", v.out, "\nexpected:", v.exp)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+type SpectralFluxOptions struct {
+	// NFFT is the length of each frame. With the default Hop, it must be at
+	// least 4.
+	//
+	// The default value is 1024.
+	NFFT int
+
+	// Hop is the number of samples between the starts of consecutive frames.
+	//
+	// The default value is 0, which uses NFFT/4.
+	Hop int
+}
+
+// SpectralFlux returns the spectral flux onset detection function of x. Element
+// m is the sum over frequency bins of the increase in magnitude from frame m-1
+// to frame m, ignoring decreases; element 0 is 0. Frame m starts at sample
+// m*Hop. Peaks in the result correspond to note onsets.
+// Reference: Dixon, "Onset Detection Revisited," 2006.
+func SpectralFlux(x []float64, o *SpectralFluxOptions) []float64 {
+	nfft := o.NFFT
+	hop := o.Hop
+
+	if nfft == 0 {
+		nfft = 1024
+	}
+	if hop == 0 {
+		hop = nfft / 4
+	}
+	if nfft < 1 || hop < 1 {
+		panic("invalid frame length")
+	}
+
+	spec := stft(x, window.Hann(nfft), hop)
+	r := make([]float64, len(spec))
+	for m := 1; m < len(spec); m++ {
+		for k, v := range spec[m] {
+			if d := cmplx.Abs(v) - cmplx.Abs(spec[m-1][k]); d > 0 {
+				r[m] += d
+			}
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSpectralFlux(t *testing.T) {
+	const (
+		nfft = 512
+		hop  = 128
+	)
+
+	// exponentially decaying noise bursts
+	hits := []int{2000, 6000, 9000, 15000}
+	x := make([]float64, 20000)
+	r := rand.New(rand.NewSource(1))
+	for _, h := range hits {
+		for i := h; i < len(x); i++ {
+			x[i] += r.NormFloat64() * math.Exp(-float64(i-h)/300)
+		}
+	}
+
+	flux := SpectralFlux(x, &SpectralFluxOptions{NFFT: nfft, Hop: hop})
+	if n := (len(x)-nfft)/hop + 1; len(flux) != n {
+		t.Fatal("SpectralFlux length error\noutput:", len(flux), "\nexpected:", n)
+	}
+
+	// the strongest peak near each hit is within a frame of it
+	var peak float64
+	for _, v := range flux {
+		peak = math.Max(peak, v)
+	}
+	for _, h := range hits {
+		best := 0
+		for m := max((h-2*nfft)/hop, 0); m < min((h+nfft)/hop, len(flux)); m++ {
+			if flux[m] > flux[best] {
+				best = m
+			}
+		}
+
+		// the frame whose end first covers the onset sees the largest increase
+		if end := best*hop + nfft; end < h || end > h+nfft || flux[best] < peak/4 {
+			t.Error("SpectralFlux error\nhit:", h, "\nframe:", best, "\nflux:", flux[best], "\nmax:", peak)
+		}
+	}
+}
+
+func TestSpectralFluxShortFrame(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SpectralFlux with NFFT 2 did not panic")
+		}
+	}()
+	SpectralFlux(make([]float64, 100), &SpectralFluxOptions{NFFT: 2})
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+)
+
+// GoertzelDetector measures the amplitude of a single frequency in
+// consecutive fixed-length blocks of a stream using the Goertzel algorithm,
+// which costs one multiplication per sample.
+type GoertzelDetector struct {
+	coeff    float64
+	blockLen int
+}
+
+// NewGoertzelDetector returns a GoertzelDetector for targetFreq in a signal
+// with sampling frequency fs, processed in blocks of blockLen samples. The
+// target frequency need not be a multiple of fs/blockLen.
+func NewGoertzelDetector(targetFreq, fs float64, blockLen int) *GoertzelDetector {
+	if blockLen < 1 {
+		panic("invalid block length")
+	}
+
+	return &GoertzelDetector{
+		coeff:    2 * math.Cos(2*math.Pi*targetFreq/fs),
+		blockLen: blockLen,
+	}
+}
+
+// Process returns the amplitude at the target frequency in block, which must
+// have blockLen samples. A sinusoid of amplitude A at the target frequency
+// returns approximately A.
+// Reference: https://en.wikipedia.org/wiki/Goertzel_algorithm
+func (g *GoertzelDetector) Process(block []float64) float64 {
+	if len(block) != g.blockLen {
+		panic("invalid block length")
+	}
+
+	var s1, s2 float64
+	for _, v := range block {
+		s1, s2 = v+g.coeff*s1-s2, s1
+	}
+
+	p := s1*s1 + s2*s2 - g.coeff*s1*s2
+	return 2 * math.Sqrt(max(p, 0)) / float64(g.blockLen)
+}
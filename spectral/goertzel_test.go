@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGoertzelDetector(t *testing.T) {
+	const (
+		fs       = 8000
+		blockLen = 205
+	)
+
+	// DTMF row and column frequencies
+	g := NewGoertzelDetector(770, fs, blockLen)
+	for _, tt := range []struct {
+		f    float64
+		high bool
+	}{
+		{770, true},
+		{697, false},
+		{852, false},
+		{1336, false},
+	} {
+		x := tone(4*blockLen, tt.f, fs)
+		for b := 0; b < len(x); b += blockLen {
+			a := g.Process(x[b : b+blockLen])
+			if tt.high && math.Abs(a-1) > 0.05 || !tt.high && a > 0.1 {
+				t.Error("GoertzelDetector error\nfrequency:", tt.f, "\nblock:", b/blockLen, "\noutput:", a, "\nexpected high:", tt.high)
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// GriffinLim returns a signal whose short-time Fourier transform magnitude
+// approximates mag, estimating the missing phase with the Griffin-Lim
+// algorithm. Each of the iterations resynthesizes the signal from mag with
+// the current phase estimate and takes the phase of its STFT.
+// mag[m] is the magnitude of the one-sided spectrum (winLen/2+1 bins) of frame
+// m, whose frames start hop samples apart. win is the analysis window used to
+// compute mag, of length winLen; if it is nil, a periodic Hann window is used.
+// The result has (len(mag)-1)*hop+winLen samples. SpectralConvergence
+// measures how well it matches mag.
+// Reference: Griffin and Lim, "Signal Estimation from Modified Short-Time
+// Fourier Transform," 1984.
+func GriffinLim(mag [][]float64, winLen, hop int, win []float64, iterations int) []float64 {
+	if win == nil {
+		win = window.HannPeriodic(winLen)
+	}
+	if len(win) != winLen {
+		panic("invalid window length")
+	}
+	if hop < 1 || hop > winLen {
+		panic("invalid hop")
+	}
+	for _, m := range mag {
+		if len(m) != winLen/2+1 {
+			panic("invalid spectrum length")
+		}
+	}
+
+	// start with zero phase
+	spec := make([][]complex128, len(mag))
+	for i, m := range mag {
+		spec[i] = make([]complex128, len(m))
+		for k, v := range m {
+			spec[i][k] = complex(v, 0)
+		}
+	}
+
+	x := istft(spec, win, hop)
+	for range iterations {
+		for i, s := range stft(x, win, hop) {
+			for k, v := range s {
+				spec[i][k] = cmplx.Rect(mag[i][k], cmplx.Phase(v))
+			}
+		}
+		x = istft(spec, win, hop)
+	}
+
+	return x
+}
+
+// SpectralConvergence returns the relative difference between the target STFT
+// magnitudes mag and the STFT magnitudes of x, computed with the window win
+// and hop samples between frames: the Frobenius norm of their difference
+// divided by that of mag. It is 0 when they match; lower values mean a more
+// consistent reconstruction.
+func SpectralConvergence(mag [][]float64, x, win []float64, hop int) float64 {
+	spec := stft(x, win, hop)
+
+	var num, den float64
+	for i, m := range mag {
+		for k, v := range m {
+			var a float64
+			if i < len(spec) {
+				a = cmplx.Abs(spec[i][k])
+			}
+			num += (v - a) * (v - a)
+			den += v * v
+		}
+	}
+	if den == 0 {
+		return 0
+	}
+	return math.Sqrt(num / den)
+}
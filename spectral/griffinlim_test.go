@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+func TestGriffinLim(t *testing.T) {
+	const (
+		n   = 512
+		hop = 128
+		fs  = 8000
+	)
+
+	// a linear chirp with a second harmonic
+	x := make([]float64, 8192)
+	for i := range x {
+		ti := float64(i) / fs
+		p := 2 * math.Pi * (200*ti + 150*ti*ti)
+		x[i] = math.Sin(p) + 0.3*math.Sin(2*p)
+	}
+
+	win := window.HannPeriodic(n)
+	spec := stft(x, win, hop)
+	mag := make([][]float64, len(spec))
+	for i, s := range spec {
+		mag[i] = make([]float64, len(s))
+		for k, v := range s {
+			mag[i][k] = cmplx.Abs(v)
+		}
+	}
+
+	y0 := GriffinLim(mag, n, hop, nil, 0)
+	y := GriffinLim(mag, n, hop, nil, 100)
+	if e := (len(mag)-1)*hop + n; len(y) != e {
+		t.Fatal("GriffinLim length error\noutput:", len(y), "\nexpected:", e)
+	}
+
+	c0 := SpectralConvergence(mag, y0, win, hop)
+	c := SpectralConvergence(mag, y, win, hop)
+	if c > 0.1 || c > c0/2 {
+		t.Error("GriffinLim convergence error\noutput:", c, "\ninitial:", c0, "\nexpected: < 0.1")
+	}
+
+	if c := SpectralConvergence(mag, x, win, hop); c > 1e-9 {
+		t.Error("SpectralConvergence error\noutput:", c, "\nexpected:", 0)
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// EstimateSNR returns the signal-to-noise ratio of x in dB, for the sampling
+// frequency fs: the power in the frequency band signalBand (low and high
+// edges in Hz, inclusive) over the power at all other frequencies. The power
+// is estimated with Pwelch using Hann-windowed segments of up to 1024 samples
+// with 50% overlap, so the band should be wider than a few multiples of
+// fs/1024 to capture the window's leakage; noise inside the band is counted as
+// signal. It returns +Inf if there is no power outside the band.
+func EstimateSNR(x []float64, fs float64, signalBand [2]float64) float64 {
+	if signalBand[0] > signalBand[1] {
+		panic("invalid signal band")
+	}
+	nfft := min(len(x), 1024)
+	p, freqs := Pwelch(x, fs, &PwelchOptions{NFFT: nfft, Noverlap: nfft / 2})
+
+	var signal, noise float64
+	for i, f := range freqs {
+		if f >= signalBand[0] && f <= signalBand[1] {
+			signal += p[i]
+		} else {
+			noise += p[i]
+		}
+	}
+
+	return 10 * math.Log10(signal/noise)
+}
+
+// THD returns the total harmonic distortion of x, for the sampling frequency
+// fs: the ratio of the root sum of squares of the amplitudes of the first
+// nHarmonics harmonics (2, 3, ... times the fundamental) to the amplitude of
+// the fundamental. The result is a ratio, not a percentage or dB. The
+// fundamental is found as the largest peak of the Blackman-windowed spectrum
+// within two bins of fundamental, and each harmonic as the largest peak
+// within two bins of the multiple of its frequency. The amplitude and
+// frequency of each peak are refined by parabolic interpolation of the log
+// magnitude, so they need not fall on a bin. Harmonics above the Nyquist
+// frequency are ignored.
+func THD(x []float64, fs, fundamental float64, nHarmonics int) float64 {
+	if nHarmonics < 1 {
+		panic("invalid number of harmonics")
+	}
+	n := len(x)
+	if fundamental <= 0 || fundamental >= fs/2 || n < 8 {
+		panic("fundamental out of range")
+	}
+
+	seg := window.Blackman(n)
+	for i, v := range x {
+		seg[i] *= v
+	}
+	spec := fft.RFFT(seg)
+	mag := make([]float64, len(spec))
+	for i, v := range spec {
+		mag[i] = cmplx.Abs(v)
+	}
+
+	binHz := fs / float64(n)
+	f0, a0 := spectralPeak(mag, fundamental/binHz)
+	var sum float64
+	for h := 2; h <= nHarmonics+1; h++ {
+		k := float64(h) * f0
+		if k > float64(len(mag)-2) {
+			break
+		}
+		_, a := spectralPeak(mag, k)
+		sum += a * a
+	}
+
+	return math.Sqrt(sum) / a0
+}
+
+// spectralPeak returns the interpolated bin and magnitude of the largest
+// peak of mag within two bins of bin k.
+func spectralPeak(mag []float64, k float64) (bin, peak float64) {
+	c := int(math.Round(k))
+	best := max(c-2, 1)
+	for i := best; i <= min(c+2, len(mag)-2); i++ {
+		if mag[i] > mag[best] {
+			best = i
+		}
+	}
+
+	// parabolic interpolation of the log magnitude around the maximum
+	l, m, r := math.Log(mag[best-1]), math.Log(mag[best]), math.Log(mag[best+1])
+	d := l + r - 2*m
+	if d >= 0 {
+		return float64(best), mag[best]
+	}
+	p := (l - r) / (2 * d)
+	return float64(best) - p, math.Exp(m - (l-r)*p/4)
+}
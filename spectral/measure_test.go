@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEstimateSNR(t *testing.T) {
+	const fs = 8000
+	rnd := rand.New(rand.NewSource(1))
+	for _, snr := range []float64{0, 10, 20, 30} {
+		// a tone of power 1/2 and white noise of power 1/2 / 10^(snr/10)
+		sigma := math.Sqrt(0.5 / math.Pow(10, snr/10))
+		x := make([]float64, 1<<16)
+		for i := range x {
+			x[i] = math.Sin(2*math.Pi*1000*float64(i)/fs) + sigma*rnd.NormFloat64()
+		}
+
+		if o := EstimateSNR(x, fs, [2]float64{950, 1050}); math.Abs(o-snr) > 1 {
+			t.Error("EstimateSNR error\ninput:", snr, "\noutput:", o, "\nexpected:", snr)
+		}
+	}
+}
+
+func TestTHD(t *testing.T) {
+	const fs = 48000
+	for _, f0 := range []float64{1000, 997.3} {
+		// harmonics 2 and 3 at 1% and 0.5% of the fundamental
+		x := make([]float64, 1<<14)
+		for i := range x {
+			w := 2 * math.Pi * f0 * float64(i) / fs
+			x[i] = math.Sin(w) + 0.01*math.Sin(2*w+0.3) + 0.005*math.Sin(3*w+1)
+		}
+
+		e := math.Hypot(0.01, 0.005)
+		if o := THD(x, fs, f0, 5); math.Abs(o-e) > 0.02*e {
+			t.Error("THD error\nfundamental:", f0, "\noutput:", o, "\nexpected:", e)
+		}
+
+		// only the second harmonic
+		if o := THD(x, fs, f0, 1); math.Abs(o-0.01) > 0.02*0.01 {
+			t.Error("THD error\nfundamental:", f0, "\noutput:", o, "\nexpected:", 0.01)
+		}
+	}
+}
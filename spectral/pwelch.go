@@ -17,7 +17,10 @@
 package spectral
 
 import (
+	"fmt"
+	"math"
 	"math/cmplx"
+	"sort"
 
 	"github.com/madelynnblue/go-dsp/dsputils"
 	"github.com/madelynnblue/go-dsp/fft"
@@ -25,8 +28,8 @@ import (
 )
 
 type PwelchOptions struct {
-	// NFFT is the number of data points used in each block for the FFT. Must be
-	// even; a power 2 is most efficient. This should *NOT* be used to get zero
+	// NFFT is the number of data points used in each block for the FFT. A
+	// power 2 is most efficient. This should *NOT* be used to get zero
 	// padding, or the scaling of the result will be incorrect. Use Pad for
 	// this instead.
 	//
@@ -48,7 +51,9 @@ type PwelchOptions struct {
 	// The value default is 0, which sets Pad equal to NFFT.
 	Pad int
 
-	// Noverlap is the number of points of overlap between blocks.
+	// Noverlap is the number of points of overlap between blocks, which must
+	// be less than the block length NFFT: 0 <= Noverlap < NFFT. For example,
+	// NFFT/2 is 50% overlap.
 	//
 	// The default value is 0 (no overlap).
 	Noverlap int
@@ -61,16 +66,88 @@ type PwelchOptions struct {
 	//
 	// The default value is false (enable scaling).
 	Scale_off bool
+
+	// Average specifies how the periodograms of each segment are combined.
+	// Median is more robust than Mean to transient interference.
+	//
+	// The default value is Mean.
+	Average Averaging
+
+	// Scaling specifies whether the result is a power spectral density or a
+	// power spectrum. Scale_off only applies to Density.
+	//
+	// The default value is Density.
+	Scaling Scaling
+
+	// AmplitudeCorrect specifies that the result is an amplitude spectrum,
+	// corrected for the coherent gain (mean value) of the window, so that a
+	// sinusoid of amplitude A centered on a bin reads A. This differs from
+	// the power correction of Density, which uses the window's equivalent
+	// noise bandwidth so that broadband noise reads correctly. Scaling and
+	// Scale_off are ignored.
+	//
+	// The default value is false.
+	AmplitudeCorrect bool
+}
+
+// Scaling is a normalization of the result of Pwelch.
+type Scaling int
+
+const (
+	// Density normalizes by Fs*sum(w^2), where w is the window, giving power
+	// per Hz. Integrating it over frequency gives the power of the signal.
+	Density Scaling = iota
+	// Spectrum normalizes by sum(w)^2, giving power per bin. The peak of a
+	// sinusoid of amplitude A centered on a bin is A^2/2.
+	Spectrum
+)
+
+// Averaging is a method of combining segment periodograms in Pwelch.
+type Averaging int
+
+const (
+	// Mean averages the segment periodograms.
+	Mean Averaging = iota
+	// Median takes the median of the segment periodograms in each frequency
+	// bin, corrected for the bias of the median of exponentially distributed
+	// values.
+	Median
+)
+
+// Validate returns an error wrapping dsputils.ErrInvalidArgument if the
+// options are out of range: NFFT or Pad negative, Pad smaller than NFFT, or
+// Noverlap outside [0, NFFT).
+func (o *PwelchOptions) Validate() error {
+	nfft := o.NFFT
+	if nfft == 0 {
+		nfft = 256
+	}
+
+	switch {
+	case nfft < 0:
+		return fmt.Errorf("spectral: NFFT %d is negative: %w", o.NFFT, dsputils.ErrInvalidArgument)
+	case o.Pad < 0 || o.Pad != 0 && o.Pad < nfft:
+		return fmt.Errorf("spectral: Pad %d is less than NFFT %d: %w", o.Pad, nfft, dsputils.ErrInvalidArgument)
+	case o.Noverlap < 0 || o.Noverlap >= nfft:
+		return fmt.Errorf("spectral: Noverlap %d is not in [0, NFFT %d): %w", o.Noverlap, nfft, dsputils.ErrInvalidArgument)
+	}
+	return nil
 }
 
 // Pwelch estimates the power spectral density of x using Welch's method.
 // Fs is the sampling frequency (samples per time unit) of x. Fs is used
 // to calculate freqs.
 // Returns the power spectral density Pxx and corresponding frequencies freqs.
+// If x is shorter than NFFT, it is zero-padded to a single segment of NFFT
+// points.
+// It panics if o is invalid; see Validate and PwelchE.
 // Designed to be similar to the matplotlib implementation below.
 // Reference: http://matplotlib.org/api/mlab_api.html#matplotlib.mlab.psd
 // See also: http://www.mathworks.com/help/signal/ref/pwelch.html
 func Pwelch(x []float64, Fs float64, o *PwelchOptions) (Pxx, freqs []float64) {
+	if err := o.Validate(); err != nil {
+		panic(err)
+	}
 	if len(x) == 0 {
 		return []float64{}, []float64{}
 	}
@@ -103,7 +180,15 @@ func Pwelch(x []float64, Fs float64, o *PwelchOptions) (Pxx, freqs []float64) {
 	segs := Segment(x, nfft, noverlap)
 
 	Pxx = make([]float64, lp)
-	for _, x := range segs {
+	var bins [][]float64
+	if o.Average == Median {
+		bins = make([][]float64, lp)
+		for j := range bins {
+			bins[j] = make([]float64, len(segs))
+		}
+	}
+
+	for i, x := range segs {
 		x = dsputils.ZeroPadF(x, pad)
 		window.Apply(x, wf)
 
@@ -112,28 +197,66 @@ func Pwelch(x []float64, Fs float64, o *PwelchOptions) (Pxx, freqs []float64) {
 		for j := range Pxx {
 			d := real(cmplx.Conj(pgram[j])*pgram[j]) / float64(len(segs))
 
-			if j > 0 && j < lp-1 {
+			if doubled(j, pad) {
 				d *= scale
 			}
 
-			Pxx[j] += d
+			if bins != nil {
+				bins[j][i] = d * float64(len(segs))
+			} else {
+				Pxx[j] += d
+			}
+		}
+	}
+
+	if bins != nil {
+		bias := medianBias(len(segs))
+		for j, b := range bins {
+			Pxx[j] = median(b) / bias
 		}
 	}
 
+	scaling := o.Scaling
+	if o.AmplitudeCorrect {
+		scaling = Spectrum
+	}
+
 	w := wf(nfft)
 	var norm float64
-	for _, x := range w {
-		norm += x * x
-	}
+	switch scaling {
+	case Density:
+		for _, x := range w {
+			norm += x * x
+		}
 
-	if enable_scaling {
-		norm *= Fs
+		if enable_scaling {
+			norm *= Fs
+		}
+	case Spectrum:
+		for _, x := range w {
+			norm += x
+		}
+		norm *= norm
+	default:
+		panic("unknown scaling")
 	}
 
 	for i := range Pxx {
 		Pxx[i] /= norm
 	}
 
+	if o.AmplitudeCorrect {
+		// undo the one-sided doubling: a sinusoid's power is half its
+		// squared amplitude
+		for i := range Pxx {
+			if doubled(i, pad) {
+				Pxx[i] = math.Sqrt(2 * Pxx[i])
+			} else {
+				Pxx[i] = math.Sqrt(Pxx[i])
+			}
+		}
+	}
+
 	freqs = make([]float64, lp)
 	coef := Fs / float64(pad)
 	for i := range freqs {
@@ -142,3 +265,43 @@ func Pwelch(x []float64, Fs float64, o *PwelchOptions) (Pxx, freqs []float64) {
 
 	return
 }
+
+// PwelchE is like Pwelch, but returns the error from o.Validate instead of
+// panicking if o is invalid.
+func PwelchE(x []float64, Fs float64, o *PwelchOptions) (Pxx, freqs []float64, err error) {
+	if err := o.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	Pxx, freqs = Pwelch(x, Fs, o)
+	return Pxx, freqs, nil
+}
+
+// doubled reports whether bin k of the one-sided spectrum of an n-point FFT
+// is doubled to include the power of its negative frequency counterpart: all
+// bins except DC and, for even n, Nyquist, which have none.
+func doubled(k, n int) bool {
+	return k > 0 && (n%2 == 1 || k < n/2)
+}
+
+// median returns the median of x, which is reordered.
+func median(x []float64) float64 {
+	sort.Float64s(x)
+	n := len(x)
+	if n%2 == 1 {
+		return x[n/2]
+	}
+	return (x[n/2-1] + x[n/2]) / 2
+}
+
+// medianBias returns the expected ratio of the median to the mean of n
+// exponentially distributed values, as used by Median averaging.
+// Reference: Allen et al., "FINDCHIRP: An algorithm for detection of
+// gravitational waves from inspiraling compact binaries," 2012, appendix B.
+func medianBias(n int) float64 {
+	b := 1.0
+	for i := 2; i < n; i += 2 {
+		b += 1/float64(i+1) - 1/float64(i)
+	}
+	return b
+}
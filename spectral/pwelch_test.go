@@ -17,9 +17,14 @@
 package spectral
 
 import (
+	"errors"
+	"math"
+	"math/rand"
 	"testing"
 
 	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
 )
 
 type pwelchTest struct {
@@ -58,3 +63,167 @@ func TestPwelch(t *testing.T) {
 		}
 	}
 }
+
+func TestPwelchMedian(t *testing.T) {
+	const (
+		fs   = 1000.0
+		nfft = 256
+	)
+
+	// White noise with unit variance has a one-sided PSD of 2/fs. Large
+	// transients are added to a few segments.
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, nfft*64)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	for _, seg := range []int{5, 20, 41} {
+		for i := seg * nfft; i < (seg+1)*nfft; i++ {
+			x[i] += 30 * math.Sin(float64(i)*0.3)
+		}
+	}
+
+	logErr := func(p []float64) float64 {
+		var e float64
+		for _, v := range p[1 : len(p)-1] {
+			e += math.Abs(math.Log10(v / (2 / fs)))
+		}
+		return e / float64(len(p)-2)
+	}
+
+	mean, _ := Pwelch(x, fs, &PwelchOptions{NFFT: nfft})
+	med, _ := Pwelch(x, fs, &PwelchOptions{NFFT: nfft, Average: Median})
+
+	if em, ed := logErr(mean), logErr(med); ed >= em || ed > 0.1 {
+		t.Error("Pwelch median error\nmedian error:", ed, "\nmean error:", em)
+	}
+}
+
+func TestMedianBias(t *testing.T) {
+	if b := medianBias(1); b != 1 {
+		t.Error("medianBias error\ninput:", 1, "\noutput:", b, "\nexpected:", 1)
+	}
+	if b := medianBias(10001); math.Abs(b-math.Ln2) > 1e-4 {
+		t.Error("medianBias error\ninput:", 10001, "\noutput:", b, "\nexpected:", math.Ln2)
+	}
+}
+
+func TestPwelchScaling(t *testing.T) {
+	const (
+		fs   = 1000
+		nfft = 256
+		amp  = 3
+	)
+
+	// a tone centered on bin 32
+	f := 32 * fs / float64(nfft)
+	x := make([]float64, 8*nfft)
+	for i := range x {
+		x[i] = amp * math.Sin(2*math.Pi*f*float64(i)/fs)
+	}
+
+	p, _ := Pwelch(x, fs, &PwelchOptions{NFFT: nfft, Scaling: Spectrum})
+	if e := amp * amp / 2.0; math.Abs(p[32]-e) > 1e-4 {
+		t.Error("Pwelch Spectrum error\noutput:", p[32], "\nexpected:", e)
+	}
+
+	// the density integrates to the power of the tone
+	p, _ = Pwelch(x, fs, &PwelchOptions{NFFT: nfft, Scaling: Density})
+	var power float64
+	for _, v := range p {
+		power += v * fs / nfft
+	}
+	if e := amp * amp / 2.0; math.Abs(power-e) > 1e-4 {
+		t.Error("Pwelch Density error\noutput:", power, "\nexpected:", e)
+	}
+}
+
+func TestPwelchAmplitudeCorrect(t *testing.T) {
+	const (
+		fs   = 1000
+		nfft = 256
+	)
+
+	// a unit tone centered on bin 40, plus a DC offset
+	f := 40 * fs / float64(nfft)
+	x := make([]float64, 8*nfft)
+	for i := range x {
+		x[i] = 0.5 + math.Sin(2*math.Pi*f*float64(i)/fs)
+	}
+
+	p, _ := Pwelch(x, fs, &PwelchOptions{NFFT: nfft, AmplitudeCorrect: true})
+	if math.Abs(p[40]-1) > 1e-4 {
+		t.Error("Pwelch AmplitudeCorrect error\noutput:", p[40], "\nexpected:", 1)
+	}
+	if math.Abs(p[0]-0.5) > 1e-4 {
+		t.Error("Pwelch AmplitudeCorrect DC error\noutput:", p[0], "\nexpected:", 0.5)
+	}
+}
+
+func TestPwelchValidate(t *testing.T) {
+	x := make([]float64, 1000)
+	for _, o := range []*PwelchOptions{
+		{Noverlap: -1},
+		{Noverlap: 256},
+		{NFFT: 64, Noverlap: 64},
+		{NFFT: 64, Noverlap: 100},
+		{NFFT: -8},
+		{NFFT: 64, Pad: 32},
+	} {
+		if _, _, err := PwelchE(x, 1, o); !errors.Is(err, dsputils.ErrInvalidArgument) {
+			t.Error("PwelchE error\ninput:", *o, "\noutput:", err, "\nexpected:", dsputils.ErrInvalidArgument)
+		}
+	}
+
+	for _, o := range []*PwelchOptions{{}, {Noverlap: 255}, {NFFT: 64, Pad: 128, Noverlap: 32}} {
+		if err := o.Validate(); err != nil {
+			t.Error("Validate error\ninput:", *o, "\noutput:", err, "\nexpected: nil")
+		}
+	}
+
+	// a signal shorter than NFFT is one zero-padded segment
+	short := []float64{1, 2, 3, 4, 5}
+	p, _, err := PwelchE(short, 1, &PwelchOptions{NFFT: 16, Window: window.Rectangular})
+	e, _ := Pwelch(dsputils.ZeroPadF(short, 16), 1, &PwelchOptions{NFFT: 16, Window: window.Rectangular})
+	if err != nil || !dsputils.PrettyClose(p, e) {
+		t.Error("Pwelch short signal error\noutput:", p, err, "\nexpected:", e)
+	}
+}
+
+func TestPwelchOneSided(t *testing.T) {
+	const fs = 100.0
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, nfft := range []int{64, 63, 9, 8} {
+		x := make([]float64, 4*nfft)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		p, _ := Pwelch(x, fs, &PwelchOptions{NFFT: nfft, Window: window.Hann})
+
+		// the two-sided density, averaged over the same segments
+		w := window.Hann(nfft)
+		var norm float64
+		for _, v := range w {
+			norm += v * v
+		}
+		var two float64
+		for s := 0; s < len(x); s += nfft {
+			seg := append([]float64(nil), x[s:s+nfft]...)
+			for i := range seg {
+				seg[i] *= w[i]
+			}
+			for _, v := range fft.FFTReal(seg) {
+				two += (real(v)*real(v) + imag(v)*imag(v)) / (fs * norm) / 4
+			}
+		}
+
+		var one float64
+		for _, v := range p {
+			one += v
+		}
+		if !dsputils.Float64Equal(one*fs/float64(nfft), two*fs/float64(nfft)) {
+			t.Error("Pwelch one-sided power error\nNFFT:", nfft, "\noutput:", one*fs/float64(nfft), "\nexpected:", two*fs/float64(nfft))
+		}
+	}
+}
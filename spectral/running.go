@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// RunningPSD estimates the power spectral density of a stream, updated one
+// segment at a time. It is an exponentially weighted version of Welch's
+// method: each segment periodogram is averaged into the estimate with a
+// weight that decays geometrically with its age, so the estimate tracks
+// slow changes without reprocessing past input.
+type RunningPSD struct {
+	win    []float64
+	forget float64
+	norm   float64
+	psd    []float64
+	n      int
+}
+
+// NewRunningPSD returns a RunningPSD for segments of segLen samples at the
+// sampling frequency fs, each scaled by win. If win is nil, window.Hann is
+// used, as in Pwelch. forget, in [0, 1), is the weight of the previous
+// estimate at each update; the effective number of averaged segments is
+// about 1/(1-forget). The result has the Density scaling of Pwelch.
+func NewRunningPSD(segLen int, win []float64, fs float64, forget float64) *RunningPSD {
+	if segLen < 1 {
+		panic("invalid segment length")
+	}
+	if win == nil {
+		win = window.Hann(segLen)
+	}
+	if len(win) != segLen {
+		panic("invalid window length")
+	}
+	if forget < 0 || forget >= 1 {
+		panic("invalid forgetting factor")
+	}
+
+	var norm float64
+	for _, w := range win {
+		norm += w * w
+	}
+
+	return &RunningPSD{
+		win:    win,
+		forget: forget,
+		norm:   norm * fs,
+		psd:    make([]float64, segLen/2+1),
+	}
+}
+
+// Update averages the periodogram of segment, which must have the segment
+// length of r, into the estimate and returns the updated estimate. Until
+// about 1/(1-forget) segments have been seen, the estimate is their plain
+// mean, the same as Pwelch without overlap.
+func (r *RunningPSD) Update(segment []float64) []float64 {
+	if len(segment) != len(r.win) {
+		panic("invalid segment length")
+	}
+
+	x := make([]float64, len(segment))
+	for i, v := range segment {
+		x[i] = v * r.win[i]
+	}
+
+	// average evenly until there are enough segments for the forgetting
+	// factor, so the first segments are not overweighted
+	r.n++
+	a := min(r.forget, 1-1/float64(r.n))
+
+	for k, v := range fft.RFFT(x) {
+		p := (real(v)*real(v) + imag(v)*imag(v)) / r.norm
+		if doubled(k, len(x)) {
+			p *= 2
+		}
+		r.psd[k] = a*r.psd[k] + (1-a)*p
+	}
+
+	return r.PSD()
+}
+
+// PSD returns a copy of the current estimate.
+func (r *RunningPSD) PSD() []float64 {
+	return append([]float64(nil), r.psd...)
+}
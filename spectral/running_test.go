@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestRunningPSD(t *testing.T) {
+	const (
+		segLen = 256
+		segs   = 3000
+		fs     = 1000.0
+	)
+
+	// a periodic input has the same periodogram in every segment, so every
+	// update matches the batch estimate
+	tone := make([]float64, 4*segLen)
+	for i := range tone {
+		tone[i] = math.Sin(2*math.Pi*float64(16*i)/segLen) + 0.5*math.Cos(2*math.Pi*float64(40*i)/segLen)
+	}
+	e, _ := Pwelch(tone, fs, &PwelchOptions{NFFT: segLen})
+	r := NewRunningPSD(segLen, nil, fs, 0.9)
+	for i := 0; i < len(tone); i += segLen {
+		if p := r.Update(tone[i : i+segLen]); !dsputils.PrettyClose(p, e) {
+			t.Error("RunningPSD periodic error\nsegment:", i/segLen, "\noutput:", p, "\nexpected:", e)
+		}
+	}
+
+	// stationary noise converges to the batch estimate
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, segs*segLen)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+	e, _ = Pwelch(x, fs, &PwelchOptions{NFFT: segLen})
+
+	// before the forgetting factor takes over, the estimate is the mean
+	r = NewRunningPSD(segLen, nil, fs, 0.99)
+	var p []float64
+	for i := 0; i < 50*segLen; i += segLen {
+		p = r.Update(x[i : i+segLen])
+	}
+	if e, _ := Pwelch(x[:50*segLen], fs, &PwelchOptions{NFFT: segLen}); !dsputils.PrettyClose(p, e) {
+		t.Error("RunningPSD warm-up error\noutput:", p, "\nexpected:", e)
+	}
+
+	r = NewRunningPSD(segLen, nil, fs, 0.999)
+	for i := 0; i < len(x); i += segLen {
+		p = r.Update(x[i : i+segLen])
+	}
+
+	var mean float64
+	for k := range p {
+		d := math.Abs(p[k]/e[k] - 1)
+		mean += d / float64(len(p))
+		if d > 0.15 {
+			t.Error("RunningPSD error\nbin:", k, "\noutput:", p[k], "\nexpected:", e[k])
+		}
+	}
+	if mean > 0.03 {
+		t.Error("RunningPSD mean error\noutput:", mean)
+	}
+}
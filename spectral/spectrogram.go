@@ -0,0 +1,266 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"context"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// SpectrogramOptions are the options of NewSpectrogram and SpectrogramChan.
+type SpectrogramOptions struct {
+	// NFFT is the length of each frame.
+	//
+	// The default value is 1024.
+	NFFT int
+
+	// Hop is the number of samples between the starts of consecutive frames.
+	//
+	// The default value is 0, which uses NFFT/4.
+	Hop int
+
+	// Window is a function that returns an array of window values the length
+	// of its input parameter. Each frame is scaled by these values.
+	//
+	// The default (nil) is window.Hann, from the go-dsp/window package.
+	Window func(int) []float64
+
+	// TwoSided specifies that each frame has all NFFT bins of its FFT,
+	// rather than the NFFT/2+1 non-negative frequency bins computed with
+	// fft.RFFT. For a real signal, the other bins are the complex conjugates
+	// of these. It is the inverse of a OneSided flag, so that the zero value
+	// gives the one-sided default.
+	//
+	// The default value is false (one-sided spectra).
+	TwoSided bool
+
+	// Normalize specifies how the spectra are scaled. SpectrogramChan cannot
+	// know the global maximum of a stream, and panics with Global.
+	//
+	// The default value is None.
+	Normalize Normalization
+}
+
+// Normalization specifies how the spectra of a spectrogram are scaled.
+type Normalization int
+
+const (
+	// None leaves the spectra unscaled.
+	None Normalization = iota
+	// Global divides every bin by the largest magnitude of the spectrogram,
+	// so that its maximum is 0 dB.
+	Global
+	// PerFrame divides the bins of each frame by the largest magnitude of
+	// the frame, so that the maximum of every frame is 0 dB.
+	PerFrame
+)
+
+// normalize scales the frames by the largest magnitude of all of them, or of
+// each frame if perFrame is true. Frames that are all zero are left as is.
+func normalize(frames [][]complex128, perFrame bool) {
+	var peak float64
+	for _, f := range frames {
+		if perFrame {
+			peak = 0
+		}
+		for _, v := range f {
+			peak = max(peak, cmplx.Abs(v))
+		}
+		if perFrame {
+			scale(f, peak)
+		}
+	}
+	if !perFrame {
+		for _, f := range frames {
+			scale(f, peak)
+		}
+	}
+}
+
+func scale(f []complex128, peak float64) {
+	if peak == 0 {
+		return
+	}
+	for k, v := range f {
+		f[k] = complex(real(v)/peak, imag(v)/peak)
+	}
+}
+
+// Spectrogram is the short-time Fourier transform of a signal: the spectra of
+// its windowed frames.
+type Spectrogram struct {
+	// Frames holds the spectrum of each frame: Frames[m][k] is bin k of frame
+	// m, which starts at sample m*Hop of the signal. Each frame has NFFT/2+1
+	// bins, or NFFT if the spectrogram is two-sided.
+	Frames [][]complex128
+
+	// NFFT is the length of each frame, and Hop is the number of samples
+	// between the starts of consecutive frames.
+	NFFT, Hop int
+
+	w        []float64 // analysis window
+	twoSided bool
+}
+
+// NewSpectrogram returns the spectrogram of x, with the same frames as
+// SpectrogramChan. Samples at the end that do not fill a frame are discarded,
+// so x shorter than NFFT has no frames.
+func NewSpectrogram(x []float64, o *SpectrogramOptions) *Spectrogram {
+	nfft, hop, w := spectrogramParams(o)
+	bins := nfft/2 + 1
+	if o.TwoSided {
+		bins = nfft
+	}
+	frames := stftBins(x, w, hop, bins)
+	switch o.Normalize {
+	case Global:
+		normalize(frames, false)
+	case PerFrame:
+		normalize(frames, true)
+	}
+
+	return &Spectrogram{
+		Frames:   frames,
+		NFFT:     nfft,
+		Hop:      hop,
+		w:        w,
+		twoSided: o.TwoSided,
+	}
+}
+
+// FreqBins returns the center frequency of each bin of the frames of s, for
+// the sampling frequency fs. For one-sided spectra and even NFFT, the last
+// bin is the Nyquist frequency fs/2; two-sided spectra are in FFT order, as
+// for dsputils.FFTFreq.
+func (s *Spectrogram) FreqBins(fs float64) []float64 {
+	if s.twoSided {
+		return dsputils.FFTFreq(s.NFFT, 1/fs)
+	}
+	return dsputils.RFFTFreq(s.NFFT, 1/fs)
+}
+
+// ISTFT returns the signal reconstructed from the frames of s, one-sided or
+// two-sided, by weighted overlap-add with the analysis window. It inverts
+// NewSpectrogram, except where the overlapping windows are near zero, such
+// as the first and last samples with a Hann window. The result has
+// (len(s.Frames)-1)*Hop+NFFT samples; the discarded samples at the end of the
+// signal are not restored. Only the real part of two-sided frames is used.
+// Global normalization scales the result, and PerFrame normalization
+// distorts it.
+func (s *Spectrogram) ISTFT() []float64 {
+	return istft(s.Frames, s.w, s.Hop)
+}
+
+// TimeBins returns the time of the center of each frame of s, in seconds for
+// the sampling frequency fs. Frame m is centered on sample m*Hop+NFFT/2.
+func (s *Spectrogram) TimeBins(fs float64) []float64 {
+	r := make([]float64, len(s.Frames))
+	for m := range r {
+		r[m] = float64(m*s.Hop+s.NFFT/2) / fs
+	}
+	return r
+}
+
+// spectrogramParams returns the frame length, hop and window given by o.
+func spectrogramParams(o *SpectrogramOptions) (nfft, hop int, w []float64) {
+	nfft, hop, wf := o.NFFT, o.Hop, o.Window
+	if nfft == 0 {
+		nfft = 1024
+	}
+	if hop == 0 {
+		hop = nfft / 4
+	}
+	if wf == nil {
+		wf = window.Hann
+	}
+	if nfft < 1 || hop < 1 {
+		panic("invalid frame length")
+	}
+	return nfft, hop, wf(nfft)
+}
+
+// SpectrogramChan returns a channel of the spectra, one-sided unless TwoSided
+// is set, of the windowed frames of the signal received from in as blocks of
+// any length.
+// Frame m starts at sample m*Hop of the signal, as if the blocks were one
+// slice; samples at the end that do not fill a frame are discarded. The
+// returned channel is closed when in is closed and the last frame is sent, or
+// when ctx is done, which stops processing without draining in.
+func SpectrogramChan(ctx context.Context, in <-chan []float64, o *SpectrogramOptions) <-chan []complex128 {
+	nfft, hop, w := spectrogramParams(o)
+	transform := fft.RFFT
+	if o.TwoSided {
+		transform = fft.FFTReal
+	}
+	if o.Normalize == Global {
+		panic("global normalization of a stream")
+	}
+	perFrame := o.Normalize == PerFrame
+	frame := func(seg []float64) []complex128 {
+		f := transform(seg)
+		if perFrame {
+			normalize([][]complex128{f}, true)
+		}
+		return f
+	}
+
+	out := make(chan []complex128)
+	go func() {
+		defer close(out)
+
+		var buf []float64
+		skip := 0 // samples to drop from the next blocks when hop > nfft
+		for {
+			var block []float64
+			select {
+			case <-ctx.Done():
+				return
+			case b, ok := <-in:
+				if !ok {
+					return
+				}
+				block = b
+			}
+
+			n := min(skip, len(block))
+			skip -= n
+			buf = append(buf, block[n:]...)
+
+			for len(buf) >= nfft {
+				seg := make([]float64, nfft)
+				for i, v := range w {
+					seg[i] = buf[i] * v
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- frame(seg):
+				}
+
+				n := min(hop, len(buf))
+				buf = append(buf[:0], buf[n:]...)
+				skip = hop - n
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,252 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"context"
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+func TestSpectrogram(t *testing.T) {
+	const fs = 8000.0
+	x := make([]float64, 1000)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * 1000 * float64(i) / fs)
+	}
+
+	s := NewSpectrogram(x, &SpectrogramOptions{NFFT: 256, Hop: 64})
+	if len(s.Frames) != 12 || len(s.Frames[0]) != 129 {
+		t.Fatal("Spectrogram size error\noutput:", len(s.Frames), len(s.Frames[0]), "\nexpected:", 12, 129)
+	}
+
+	freqs := s.FreqBins(fs)
+	if len(freqs) != 129 || freqs[128] != fs/2 {
+		t.Error("FreqBins error\noutput:", freqs[len(freqs)-1], "\nexpected:", fs/2)
+	}
+	times := s.TimeBins(fs)
+	if e := (11*64 + 128) / fs; len(times) != 12 || !dsputils.Float64Equal(times[11], e) {
+		t.Error("TimeBins error\noutput:", times, "\nexpected last:", e)
+	}
+
+	// the tone is in the bin centered on its frequency
+	peak := 0
+	for k, v := range s.Frames[5] {
+		if cmplx.Abs(v) > cmplx.Abs(s.Frames[5][peak]) {
+			peak = k
+		}
+	}
+	if freqs[peak] != 1000 {
+		t.Error("Spectrogram peak error\noutput:", freqs[peak], "\nexpected:", 1000)
+	}
+
+	if s := NewSpectrogram(x[:100], &SpectrogramOptions{NFFT: 256}); len(s.Frames) != 0 || len(s.TimeBins(fs)) != 0 {
+		t.Error("Spectrogram short input error\noutput:", len(s.Frames))
+	}
+}
+
+func TestSpectrogramRFFT(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 1500)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+
+	// the real FFT frames match the complex FFT on the non-redundant bins,
+	// for even and odd frame lengths
+	for _, nfft := range []int{128, 99} {
+		w := window.Hann(nfft)
+		s := NewSpectrogram(x, &SpectrogramOptions{NFFT: nfft, Hop: 40})
+		seg := make([]float64, nfft)
+		for m, f := range s.Frames {
+			for i := range seg {
+				seg[i] = x[m*40+i] * w[i]
+			}
+			e := fft.FFTReal(seg)[:nfft/2+1]
+			if !dsputils.PrettyCloseC(f, e) {
+				t.Error("Spectrogram RFFT error\nNFFT:", nfft, "\nframe:", m, "\noutput:", f, "\nexpected:", e)
+				break
+			}
+		}
+	}
+}
+
+func TestSpectrogramTwoSided(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 2000)
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+	}
+
+	one := NewSpectrogram(x, &SpectrogramOptions{NFFT: 128, Hop: 32})
+	two := NewSpectrogram(x, &SpectrogramOptions{NFFT: 128, Hop: 32, TwoSided: true})
+	if len(one.Frames) != len(two.Frames) || len(one.Frames[0]) != 65 || len(two.Frames[0]) != 128 {
+		t.Fatal("Spectrogram TwoSided size error\noutput:", len(one.Frames[0]), len(two.Frames[0]), "\nexpected:", 65, 128)
+	}
+	for m := range one.Frames {
+		if !dsputils.PrettyCloseC(one.Frames[m], two.Frames[m][:65]) {
+			t.Error("Spectrogram TwoSided error\nframe:", m, "\noutput:", two.Frames[m][:65], "\nexpected:", one.Frames[m])
+			break
+		}
+	}
+	if f := two.FreqBins(8000); len(f) != 128 || f[1] != 62.5 || f[127] != -62.5 {
+		t.Error("FreqBins TwoSided error\noutput:", f)
+	}
+
+	// both reconstruct x where the windows overlap
+	for _, s := range []*Spectrogram{one, two} {
+		y := s.ISTFT()
+		if n := (len(s.Frames)-1)*s.Hop + s.NFFT; len(y) != n {
+			t.Fatal("ISTFT length error\noutput:", len(y), "\nexpected:", n)
+		}
+		if !dsputils.PrettyClose(y[s.NFFT:len(y)-s.NFFT], x[s.NFFT:len(y)-s.NFFT]) {
+			t.Error("ISTFT error\nbins:", len(s.Frames[0]))
+		}
+	}
+}
+
+func TestSpectrogramNormalize(t *testing.T) {
+	x := make([]float64, 3000)
+	for i := range x {
+		// a tone that fades in
+		x[i] = float64(i) / 3000 * math.Sin(0.3*float64(i))
+	}
+	o := &SpectrogramOptions{NFFT: 256, Hop: 128}
+	raw := NewSpectrogram(x, o)
+
+	peakDB := func(f []complex128) float64 {
+		var p float64
+		for _, v := range f {
+			p = max(p, cmplx.Abs(v))
+		}
+		return 20 * math.Log10(p)
+	}
+
+	o.Normalize = Global
+	g := NewSpectrogram(x, o)
+	top := math.Inf(-1)
+	for _, f := range g.Frames {
+		top = max(top, peakDB(f))
+	}
+	if math.Abs(top) > 1e-12 {
+		t.Error("Spectrogram Global error\noutput:", top, "dB\nexpected:", 0)
+	}
+	// the frames keep their relative levels
+	if d, e := peakDB(g.Frames[0])-peakDB(g.Frames[20]), peakDB(raw.Frames[0])-peakDB(raw.Frames[20]); !dsputils.Float64Equal(d, e) {
+		t.Error("Spectrogram Global level error\noutput:", d, "\nexpected:", e)
+	}
+
+	o.Normalize = PerFrame
+	p := NewSpectrogram(x, o)
+	for m, f := range p.Frames {
+		if db := peakDB(f); math.Abs(db) > 1e-12 {
+			t.Error("Spectrogram PerFrame error\nframe:", m, "\noutput:", db, "dB\nexpected:", 0)
+			break
+		}
+	}
+
+	// SpectrogramChan normalizes frames the same way
+	in := make(chan []float64, 1)
+	in <- x
+	close(in)
+	m := 0
+	for f := range SpectrogramChan(context.Background(), in, o) {
+		if !dsputils.PrettyCloseC(f, p.Frames[m]) {
+			t.Error("SpectrogramChan PerFrame error\nframe:", m, "\noutput:", f, "\nexpected:", p.Frames[m])
+			break
+		}
+		m++
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SpectrogramChan with Global did not panic")
+		}
+	}()
+	SpectrogramChan(context.Background(), in, &SpectrogramOptions{Normalize: Global})
+}
+
+func TestSpectrogramChan(t *testing.T) {
+	x := make([]float64, 5000)
+	for i := range x {
+		x[i] = math.Sin(0.05*float64(i)) + 0.3*math.Cos(0.7*float64(i))
+	}
+
+	for _, tt := range []struct {
+		hop      int
+		twoSided bool
+	}{{64, false}, {300, false}, {64, true}} {
+		hop := tt.hop
+		bins := 129
+		if tt.twoSided {
+			bins = 256
+		}
+		e := stftBins(x, window.Hann(256), hop, bins)
+
+		in := make(chan []float64)
+		go func() {
+			for i := 0; i < len(x); i += 333 {
+				in <- x[i:min(i+333, len(x))]
+			}
+			close(in)
+		}()
+
+		var frames [][]complex128
+		for f := range SpectrogramChan(context.Background(), in, &SpectrogramOptions{NFFT: 256, Hop: hop, TwoSided: tt.twoSided}) {
+			frames = append(frames, f)
+		}
+		if len(frames) != len(e) {
+			t.Error("SpectrogramChan frames error\nhop:", hop, "\noutput:", len(frames), "\nexpected:", len(e))
+			continue
+		}
+		for m := range frames {
+			if !dsputils.PrettyCloseC(frames[m], e[m]) {
+				t.Error("SpectrogramChan error\nhop:", hop, "\nframe:", m, "\noutput:", frames[m], "\nexpected:", e[m])
+				break
+			}
+		}
+	}
+}
+
+func TestSpectrogramChanCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan []float64)
+	out := SpectrogramChan(ctx, in, &SpectrogramOptions{NFFT: 16})
+
+	// a frame is pending when ctx is canceled, and in is never closed
+	in <- make([]float64, 16)
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range out {
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SpectrogramChan did not stop on cancellation")
+	}
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// stft returns the one-sided (len(w)/2+1 bin) spectra of the frames of x
+// windowed by w, with hop samples between frames. Trailing samples of x that
+// do not fill a frame are discarded.
+func stft(x, w []float64, hop int) [][]complex128 {
+	return stftBins(x, w, hop, len(w)/2+1)
+}
+
+// stftBins is like stft, but returns the first bins bins of each spectrum, so
+// bins of len(w) gives two-sided spectra. One-sided spectra are computed with
+// the real FFT.
+func stftBins(x, w []float64, hop, bins int) [][]complex128 {
+	n := len(w)
+	if len(x) < n {
+		return nil
+	}
+
+	seg := make([]float64, n)
+	r := make([][]complex128, (len(x)-n)/hop+1)
+	for m := range r {
+		for i := range seg {
+			seg[i] = x[m*hop+i] * w[i]
+		}
+		if bins == n/2+1 {
+			r[m] = fft.RFFT(seg)
+		} else {
+			r[m] = fft.FFTReal(seg)[:bins]
+		}
+	}
+
+	return r
+}
+
+// istft returns the signal reconstructed from one-sided spectra, or two-sided
+// spectra of len(w) bins, by weighted overlap-add, using the synthesis window
+// w and hop samples between frames.
+// Each sample is normalized by the sum of the squared windows overlapping it,
+// so istft inverts stft for the same w and hop, except where the windows are
+// near zero.
+func istft(spec [][]complex128, w []float64, hop int) []float64 {
+	n := len(w)
+	if len(spec) == 0 {
+		return []float64{}
+	}
+
+	out := make([]float64, (len(spec)-1)*hop+n)
+	y := make([]complex128, n)
+
+	for m, s := range spec {
+		if len(s) == n {
+			copy(y, s)
+		} else {
+			for k, v := range s {
+				y[k] = v
+				if k > 0 && k < n-k {
+					y[n-k] = complex(real(v), -imag(v))
+				}
+			}
+		}
+
+		for i, v := range fft.IFFT(y) {
+			out[m*hop+i] += real(v) * w[i]
+		}
+	}
+
+	w2 := make([]float64, n)
+	for i, v := range w {
+		w2[i] = v * v
+	}
+	norm := window.OverlapAddNorm(w2, hop, len(out))
+	for i := range out {
+		if norm[i] > 1e-6 {
+			out[i] /= norm[i]
+		}
+	}
+
+	return out
+}
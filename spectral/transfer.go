@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// TransferFunction estimates the frequency response h of a linear system from
+// its input and output with the H1 estimator: the cross spectral density of
+// input and output divided by the power spectral density of the input, both
+// estimated with Welch's method. H1 is unbiased by noise added to the output.
+// Fs is the sampling frequency, used to calculate freqs, which are the
+// one-sided frequencies returned by Pwelch for the same options. NFFT,
+// Window, Pad, and Noverlap of o are used; the other options do not apply.
+// It panics if o is invalid or input and output differ in length.
+func TransferFunction(input, output []float64, Fs float64, o *PwelchOptions) (freqs []float64, h []complex128) {
+	if len(input) != len(output) {
+		panic("input and output lengths differ")
+	}
+
+	pxy, pxx, pad := crossSpectra(input, output, o)
+	h = make([]complex128, len(pxy))
+	freqs = make([]float64, len(pxy))
+	for i := range h {
+		h[i] = pxy[i] / complex(pxx[i], 0)
+		freqs[i] = float64(i) * Fs / float64(pad)
+	}
+
+	return freqs, h
+}
+
+// CrossPhase returns the unwrapped phase, in radians, of the cross spectral
+// density of x and y estimated with Welch's method: the phase of Y relative
+// to X at each frequency. For y a copy of x delayed by d seconds, the phase is
+// -2*Pi*f*d, so the delay is minus the slope of phase against freqs over
+// 2*Pi. The phase is unwrapped from DC upward, so it is only meaningful where
+// the cross spectrum is well above the noise. Fs and o are as for
+// TransferFunction. It panics if o is invalid or x and y differ in length.
+func CrossPhase(x, y []float64, Fs float64, o *PwelchOptions) (freqs, phase []float64) {
+	if len(x) != len(y) {
+		panic("input lengths differ")
+	}
+
+	pxy, _, pad := crossSpectra(x, y, o)
+	freqs = make([]float64, len(pxy))
+	phase = make([]float64, len(pxy))
+	for i, v := range pxy {
+		freqs[i] = float64(i) * Fs / float64(pad)
+		phase[i] = cmplx.Phase(v)
+		if i > 0 {
+			phase[i] = phase[i-1] + princarg(phase[i]-cmplx.Phase(pxy[i-1]))
+		}
+	}
+
+	return freqs, phase
+}
+
+// crossSpectra returns the unnormalized one-sided Welch averages of
+// conj(X)*Y and |X|^2 over the segments of x and y, where X and Y are the
+// FFTs of the windowed segments, and the padded FFT length. The ratio of the
+// averages is the H1 estimate.
+func crossSpectra(x, y []float64, o *PwelchOptions) (pxy []complex128, pxx []float64, pad int) {
+	if err := o.Validate(); err != nil {
+		panic(err)
+	}
+	if len(x) == 0 {
+		return []complex128{}, []float64{}, 0
+	}
+
+	nfft, wf := o.NFFT, o.Window
+	pad = o.Pad
+	if nfft == 0 {
+		nfft = 256
+	}
+	if wf == nil {
+		wf = window.Hann
+	}
+	if pad == 0 {
+		pad = nfft
+	}
+	if len(x) < nfft {
+		x, y = dsputils.ZeroPadF(x, nfft), dsputils.ZeroPadF(y, nfft)
+	}
+
+	lp := pad/2 + 1
+	pxy, pxx = make([]complex128, lp), make([]float64, lp)
+	w := wf(nfft)
+	xs, ys := Segment(x, nfft, o.Noverlap), Segment(y, nfft, o.Noverlap)
+	for i := range xs {
+		a, b := dsputils.ZeroPadF(xs[i], pad), dsputils.ZeroPadF(ys[i], pad)
+		for j, v := range w {
+			a[j] *= v
+			b[j] *= v
+		}
+
+		fa, fb := fft.RFFT(a), fft.RFFT(b)
+		for j := range pxy {
+			pxy[j] += cmplx.Conj(fa[j]) * fb[j]
+			pxx[j] += real(fa[j])*real(fa[j]) + imag(fa[j])*imag(fa[j])
+		}
+	}
+
+	return pxy, pxx, pad
+}
@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+)
+
+func TestTransferFunction(t *testing.T) {
+	b := []float64{0.5, 0.3, -0.2, 0.1}
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 1<<14)
+	y := make([]float64, len(x))
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		for k, v := range b {
+			if i >= k {
+				y[i] += v * x[i-k]
+			}
+		}
+	}
+
+	const fs = 1000
+	freqs, h := TransferFunction(x, y, fs, &PwelchOptions{NFFT: 128, Noverlap: 64})
+	if len(h) != 65 || len(freqs) != 65 || freqs[64] != fs/2 {
+		t.Fatal("TransferFunction length error\noutput:", len(h), len(freqs))
+	}
+	for i, f := range freqs {
+		var e complex128
+		for k, v := range b {
+			e += complex(v, 0) * cmplx.Exp(complex(0, -2*math.Pi*f/fs*float64(k)))
+		}
+		if math.Abs(cmplx.Abs(h[i])-cmplx.Abs(e)) > 0.01 {
+			t.Error("TransferFunction magnitude error\nfrequency:", f, "\noutput:", cmplx.Abs(h[i]), "\nexpected:", cmplx.Abs(e))
+		}
+		if cmplx.Abs(h[i]-e) > 0.02 {
+			t.Error("TransferFunction error\nfrequency:", f, "\noutput:", h[i], "\nexpected:", e)
+		}
+	}
+}
+
+func TestCrossPhase(t *testing.T) {
+	const fs, delay = 1000, 5
+	rnd := rand.New(rand.NewSource(1))
+	x := make([]float64, 1<<14)
+	y := make([]float64, len(x))
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		if i >= delay {
+			y[i] = x[i-delay]
+		}
+	}
+
+	freqs, phase := CrossPhase(x, y, fs, &PwelchOptions{NFFT: 256, Noverlap: 128})
+	if len(freqs) != 129 || len(phase) != 129 {
+		t.Fatal("CrossPhase length error\noutput:", len(freqs), len(phase))
+	}
+	for i, f := range freqs {
+		if e := -2 * math.Pi * f * delay / fs; math.Abs(phase[i]-e) > 0.05 {
+			t.Error("CrossPhase error\nfrequency:", f, "\noutput:", phase[i], "\nexpected:", e)
+			break
+		}
+	}
+}
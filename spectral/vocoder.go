@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/window"
+)
+
+// TimeStretch returns x, sampled at fs, stretched in time by factor without
+// changing its pitch, using a phase vocoder. The result has len(x)*factor
+// samples, rounded to the nearest integer; factor > 1 slows x down.
+func TimeStretch(x []float64, factor, fs float64) []float64 {
+	// windows of at least 50 ms with 75% overlap
+	n := max(dsputils.NextPowerOf2(int(fs*0.05)), 64)
+	return NewPhaseVocoder(n, n/4).Process(x, factor)
+}
+
+// PhaseVocoder stretches signals in time without changing their pitch.
+type PhaseVocoder struct {
+	winLen, hop int
+	win         []float64
+}
+
+// NewPhaseVocoder returns a PhaseVocoder which uses periodic Hann windows of
+// length winLen with a hop of hop samples between frames. A hop of winLen/4
+// is typical.
+func NewPhaseVocoder(winLen, hop int) *PhaseVocoder {
+	if winLen < 2 {
+		panic("invalid window length")
+	}
+	if hop < 1 || hop > winLen/2 {
+		panic("invalid hop")
+	}
+
+	return &PhaseVocoder{winLen, hop, window.HannPeriodic(winLen)}
+}
+
+// Process returns x stretched in time by stretch, which has len(x)*stretch
+// samples, rounded to the nearest integer.
+//
+// Frames are resynthesized at a fixed hop, reading the analysis frames at
+// fractional positions: magnitudes are interpolated, and the phase of each
+// spectral peak advances by its measured phase difference between adjacent
+// analysis frames. The phases of the bins around each peak are locked to it,
+// keeping their analysis phase differences, which reduces the "phasiness" of
+// the result.
+// Reference: Laroche and Dolson, "Improved Phase Vocoder Time-Scale
+// Modification of Audio," 1999.
+func (p *PhaseVocoder) Process(x []float64, stretch float64) []float64 {
+	if stretch <= 0 {
+		panic("invalid stretch factor")
+	}
+	if len(x) == 0 {
+		return []float64{}
+	}
+
+	n, hop, w := p.winLen, p.hop, p.win
+	nb := n/2 + 1
+
+	// Pad the end so the last samples are fully covered by frames, with one
+	// extra frame to measure the phase advance of the last one.
+	xp := make([]float64, len(x)+n+hop)
+	copy(xp, x)
+
+	spec := stft(xp, w, hop)
+	mags := make([][]float64, len(spec))
+	phases := make([][]float64, len(spec))
+	for m, s := range spec {
+		mags[m] = make([]float64, nb)
+		phases[m] = make([]float64, nb)
+		for k, v := range s {
+			mags[m][k], phases[m][k] = cmplx.Polar(v)
+		}
+	}
+
+	steps := int(float64(len(mags)-2)*stretch) + 1
+	frames := make([][]complex128, steps)
+
+	acc := make([]float64, nb)
+	copy(acc, phases[0])
+	mag := make([]float64, nb)
+	theta := make([]float64, nb)
+
+	for t := range frames {
+		pos := float64(t) / stretch
+		m := int(pos)
+		a := pos - float64(m)
+
+		for k := range mag {
+			mag[k] = (1-a)*mags[m][k] + a*mags[m+1][k]
+		}
+
+		// Lock each bin to the peak whose region it is in.
+		peaks := findPeaks(mag)
+		for k, pi := 0, 0; k < nb; k++ {
+			if len(peaks) == 0 {
+				theta[k] = acc[k]
+				continue
+			}
+			for pi+1 < len(peaks) && k-peaks[pi] > peaks[pi+1]-k {
+				pi++
+			}
+			pk := peaks[pi]
+			theta[k] = acc[pk] + phases[m][k] - phases[m][pk]
+		}
+
+		frames[t] = make([]complex128, nb)
+		for k := range nb {
+			frames[t][k] = cmplx.Rect(mag[k], theta[k])
+
+			omega := 2 * math.Pi * float64(k) / float64(n) * float64(hop)
+			d := phases[m+1][k] - phases[m][k] - omega
+			acc[k] = theta[k] + omega + princarg(d)
+		}
+	}
+
+	out := istft(frames, w, hop)
+	r := make([]float64, int(math.Round(float64(len(x))*stretch)))
+	copy(r, out)
+	return r
+}
+
+// findPeaks returns the indexes of the values of x larger than their two
+// neighbors on each side.
+func findPeaks(x []float64) []int {
+	var r []int
+	for k, v := range x {
+		peak := v > 0
+		for d := -2; d <= 2 && peak; d++ {
+			if j := k + d; d != 0 && j >= 0 && j < len(x) && x[j] >= v {
+				peak = false
+			}
+		}
+		if peak {
+			r = append(r, k)
+		}
+	}
+	return r
+}
+
+// princarg returns the phase p wrapped to [-pi, pi).
+func princarg(p float64) float64 {
+	return p - 2*math.Pi*math.Floor((p+math.Pi)/(2*math.Pi))
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+// peakFreq returns the frequency of the largest FFT bin of x, sampled at fs.
+func peakFreq(x []float64, fs float64) float64 {
+	s := fft.FFTReal(x)
+	var best int
+	for k := 1; k < len(s)/2; k++ {
+		if cmplx.Abs(s[k]) > cmplx.Abs(s[best]) {
+			best = k
+		}
+	}
+	return float64(best) * fs / float64(len(s))
+}
+
+func tone(n int, f, fs float64) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = math.Sin(2 * math.Pi * f * float64(i) / fs)
+	}
+	return x
+}
+
+func TestTimeStretch(t *testing.T) {
+	const (
+		fs = 8000.0
+		f  = 440.0
+	)
+
+	x := tone(8000, f, fs)
+	for _, factor := range []float64{2, 0.5, 1.5} {
+		y := TimeStretch(x, factor, fs)
+
+		if want := int(math.Round(float64(len(x)) * factor)); len(y) != want {
+			t.Error("TimeStretch length error\nfactor:", factor, "\noutput:", len(y), "\nexpected:", want)
+		}
+
+		// measure the frequency away from the edges
+		mid := y[len(y)/4 : len(y)/4+2048]
+		if pf := peakFreq(mid, fs); math.Abs(pf-f) > fs/2048 {
+			t.Error("TimeStretch frequency error\nfactor:", factor, "\noutput:", pf, "\nexpected:", f)
+		}
+
+		var rms float64
+		for _, v := range mid {
+			rms += v * v
+		}
+		if rms = math.Sqrt(rms / float64(len(mid))); math.Abs(rms-math.Sqrt2/2) > 0.05 {
+			t.Error("TimeStretch amplitude error\nfactor:", factor, "\noutput:", rms, "\nexpected:", math.Sqrt2/2)
+		}
+	}
+}
+
+func TestPhaseVocoder(t *testing.T) {
+	const (
+		fs = 8000.0
+		f  = 440.0
+	)
+
+	// a tone faded in from silence
+	x := tone(8000, f, fs)
+	for i := range 1000 {
+		x[i] *= float64(i) / 1000
+	}
+
+	pv := NewPhaseVocoder(512, 128)
+
+	// away from the start, where the window is near zero
+	y := pv.Process(x, 1)
+	for i := 512; i < len(y); i++ {
+		if math.Abs(y[i]-x[i]) > 1e-9 {
+			t.Error("PhaseVocoder identity error\nindex:", i, "\noutput:", y[i], "\nexpected:", x[i])
+			break
+		}
+	}
+
+	for _, stretch := range []float64{0.75, 1.5, 2, 3} {
+		y := pv.Process(x, stretch)
+		if want := int(math.Round(float64(len(x)) * stretch)); len(y) != want {
+			t.Error("PhaseVocoder length error\nstretch:", stretch, "\noutput:", len(y), "\nexpected:", want)
+		}
+
+		mid := y[len(y)/2-1024 : len(y)/2+1024]
+		if pf := peakFreq(mid, fs); math.Abs(pf-f) > fs/2048 {
+			t.Error("PhaseVocoder frequency error\nstretch:", stretch, "\noutput:", pf, "\nexpected:", f)
+		}
+
+		// Without phase locking, the amplitude drops after the fade-in.
+		var peak float64
+		for _, v := range mid {
+			peak = math.Max(peak, math.Abs(v))
+		}
+		if math.Abs(peak-1) > 0.05 {
+			t.Error("PhaseVocoder amplitude error\nstretch:", stretch, "\noutput:", peak, "\nexpected:", 1)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+type YINOptions struct {
+	// Threshold is the absolute threshold on the cumulative mean normalized
+	// difference function. The smallest period whose value falls below it is
+	// chosen.
+	//
+	// The default value is 0, which uses 0.1.
+	Threshold float64
+
+	// MinFreq and MaxFreq bound the fundamental frequencies searched, in the
+	// units of fs.
+	//
+	// The default values are 0, which use 40 and 2000.
+	MinFreq, MaxFreq float64
+}
+
+// YIN returns the estimated fundamental frequency of x, which has sampling
+// frequency fs, using the YIN algorithm. The difference function is computed
+// with an FFT-based cross-correlation. x must be longer than fs/MinFreq
+// samples; twice that gives the most reliable estimates. If no period falls
+// below the threshold, the period with the smallest difference is used.
+// Reference: de Cheveigné and Kawahara, "YIN, a fundamental frequency
+// estimator for speech and music," 2002.
+func YIN(x []float64, fs float64, o *YINOptions) float64 {
+	threshold := o.Threshold
+	minFreq := o.MinFreq
+	maxFreq := o.MaxFreq
+
+	if threshold == 0 {
+		threshold = 0.1
+	}
+	if minFreq == 0 {
+		minFreq = 40
+	}
+	if maxFreq == 0 {
+		maxFreq = 2000
+	}
+
+	maxLag := int(math.Ceil(fs / minFreq))
+	minLag := max(int(fs/maxFreq), 2)
+	if len(x) <= maxLag+1 {
+		panic("signal too short")
+	}
+
+	// d(τ) = Σ (x[j] - x[j+τ])² over j < w, expanded into energies and a
+	// cross-correlation
+	w := len(x) - maxLag - 1
+	_, c := fft.MatchedFilter(x, x[:w])
+
+	var e0 float64
+	for _, v := range x[:w] {
+		e0 += v * v
+	}
+
+	// cumulative mean normalized difference
+	cmnd := make([]float64, maxLag+2)
+	cmnd[0] = 1
+	et := e0
+	var sum float64
+	for tau := 1; tau < len(cmnd); tau++ {
+		a, b := x[tau-1], x[tau+w-1]
+		et += b*b - a*a
+		d := max(e0+et-2*c[tau], 0)
+		sum += d
+		if sum > 0 {
+			cmnd[tau] = d * float64(tau) / sum
+		} else {
+			cmnd[tau] = 1
+		}
+	}
+
+	best := -1
+	for tau := minLag; tau <= maxLag; tau++ {
+		if cmnd[tau] < threshold {
+			// descend to the local minimum
+			for tau < maxLag && cmnd[tau+1] < cmnd[tau] {
+				tau++
+			}
+			best = tau
+			break
+		}
+	}
+	if best < 0 {
+		best = minLag
+		for tau := minLag; tau <= maxLag; tau++ {
+			if cmnd[tau] < cmnd[best] {
+				best = tau
+			}
+		}
+	}
+
+	// parabolic interpolation around the minimum
+	period := float64(best)
+	if l, m, r := cmnd[best-1], cmnd[best], cmnd[best+1]; l+r-2*m > 0 {
+		period += (l - r) / (2 * (l + r - 2*m))
+	}
+
+	return fs / period
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package spectral
+
+import (
+	"math"
+	"testing"
+)
+
+func TestYIN(t *testing.T) {
+	const fs = 16000
+
+	for _, f0 := range []float64{82.4, 110, 220, 261.63, 440.5, 997} {
+		// a voiced signal with decaying harmonics
+		x := make([]float64, 2048)
+		for h := 1; h <= 8; h++ {
+			for i := range x {
+				x[i] += math.Sin(2*math.Pi*f0*float64(h)*float64(i)/fs+float64(h)) / float64(h)
+			}
+		}
+
+		f := YIN(x, fs, &YINOptions{})
+		if cents := 1200 * math.Log2(f/f0); math.Abs(cents) > 5 {
+			t.Error("YIN error\ninput:", f0, "\noutput:", f, "\ncents:", cents)
+		}
+	}
+}
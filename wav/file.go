@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package wav
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// RandomAccessWav reads arbitrary ranges of samples from a WAV file, without
+// reading the samples before them.
+type RandomAccessWav struct {
+	Wav
+
+	f   *os.File
+	off int64 // file offset of the sample data
+}
+
+// OpenFile opens the WAV file at path for random access and reads its header
+// and metadata. The caller must Close it.
+func OpenFile(path string) (*RandomAccessWav, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := New(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	off, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &RandomAccessWav{Wav: *w, f: f, off: off}, nil
+}
+
+// Close closes the underlying file.
+func (w *RandomAccessWav) Close() error {
+	return w.f.Close()
+}
+
+// Frames returns the number of sample frames (samples per channel) in the
+// file.
+func (w *RandomAccessWav) Frames() int {
+	return w.Samples / int(w.NumChannels)
+}
+
+// ReadRange returns count samples of channel channel (0 is the first),
+// starting at sample frame startSample, converted to float64 like ReadFloats
+// does. It is safe to call concurrently.
+func (w *RandomAccessWav) ReadRange(startSample, count, channel int) ([]float64, error) {
+	if channel < 0 || channel >= int(w.NumChannels) {
+		return nil, fmt.Errorf("wav: channel %d out of range: %w", channel, dsputils.ErrInvalidArgument)
+	}
+	if startSample < 0 || count < 0 || startSample+count > w.Frames() {
+		return nil, fmt.Errorf("wav: samples [%d, %d) out of range: %w", startSample, startSample+count, dsputils.ErrInvalidArgument)
+	}
+
+	align := int(w.BlockAlign)
+	size := int(w.BitsPerSample) / 8
+	if align != int(w.NumChannels)*size {
+		return nil, fmt.Errorf("wav: bad block align: %v", w.BlockAlign)
+	}
+	b := make([]byte, count*align)
+	if _, err := w.f.ReadAt(b, w.off+int64(startSample*align)); err != nil {
+		return nil, err
+	}
+
+	r := make([]float64, count)
+	for i := range r {
+		p := b[i*align+channel*size:]
+		switch {
+		case w.AudioFormat == wavFormatPCM && size == 1:
+			r[i] = float64(float32(p[0]) / math.MaxUint8)
+		case w.AudioFormat == wavFormatPCM && size == 2:
+			v := int16(binary.LittleEndian.Uint16(p))
+			r[i] = float64((float32(v) - math.MinInt16) / (math.MaxInt16 - math.MinInt16))
+		case w.AudioFormat == wavFormatIEEEFloat && size == 4:
+			r[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(p)))
+		default:
+			return nil, fmt.Errorf("wav: unknown bits per sample: %v: %w", w.BitsPerSample, dsputils.ErrUnsupportedFormat)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// metadata collects the metadata chunks of a WAV file as they are read.
+type metadata struct {
+	cues    []cuePoint
+	labels  map[uint32]string
+	sampler *Sampler
+}
+
+type cuePoint struct {
+	id, position uint32
+}
+
+// readChunk reads the body of the chunk typ of size sz, and its pad byte,
+// from r. Chunks other than metadata are discarded.
+func (m *metadata) readChunk(r io.Reader, typ string, sz uint32) error {
+	n := int64(sz) + int64(sz%2)
+	switch typ {
+	case "cue ", "LIST", "smpl":
+	default:
+		_, err := io.CopyN(io.Discard, r, n)
+		return err
+	}
+
+	// the size is untrusted, so the buffer grows only as data arrives
+	b, err := io.ReadAll(io.LimitReader(r, n))
+	if err != nil {
+		return err
+	}
+	if int64(len(b)) < n {
+		return io.ErrUnexpectedEOF
+	}
+	b = b[:sz]
+
+	switch typ {
+	case "cue ":
+		if len(b) < 4 {
+			return fmt.Errorf("wav: bad cue size")
+		}
+		count := binary.LittleEndian.Uint32(b)
+		if uint64(len(b)) < 4+24*uint64(count) {
+			return fmt.Errorf("wav: bad cue size")
+		}
+		for i := range int(count) {
+			p := b[4+24*i:]
+			m.cues = append(m.cues, cuePoint{
+				id:       binary.LittleEndian.Uint32(p),
+				position: binary.LittleEndian.Uint32(p[20:]),
+			})
+		}
+	case "smpl":
+		// manufacturer, product, sample period, MIDI unity note, MIDI pitch
+		// fraction, SMPTE format and offset, loop count, sampler data size,
+		// then 24 bytes per loop
+		if len(b) < 36 {
+			return fmt.Errorf("wav: bad smpl size")
+		}
+		count := binary.LittleEndian.Uint32(b[28:])
+		if uint64(len(b)) < 36+24*uint64(count) {
+			return fmt.Errorf("wav: bad smpl size")
+		}
+		s := &Sampler{
+			RootNote:      binary.LittleEndian.Uint32(b[12:]),
+			PitchFraction: binary.LittleEndian.Uint32(b[16:]),
+		}
+		for i := range int(count) {
+			// cue point ID, type, start, end, fraction, play count
+			p := b[36+24*i:]
+			s.Loops = append(s.Loops, Loop{
+				Type:      LoopType(binary.LittleEndian.Uint32(p[4:])),
+				Start:     binary.LittleEndian.Uint32(p[8:]),
+				End:       binary.LittleEndian.Uint32(p[12:]),
+				PlayCount: binary.LittleEndian.Uint32(p[20:]),
+			})
+		}
+		m.sampler = s
+	case "LIST":
+		if len(b) < 4 || string(b[:4]) != "adtl" {
+			return nil
+		}
+		for b = b[4:]; len(b) >= 8; {
+			st := string(b[:4])
+			ssz := int(binary.LittleEndian.Uint32(b[4:]))
+			if ssz > len(b)-8 {
+				return fmt.Errorf("wav: bad %s size", st)
+			}
+			if st == "labl" && ssz >= 4 {
+				if m.labels == nil {
+					m.labels = map[uint32]string{}
+				}
+				text, _, _ := bytes.Cut(b[12:8+ssz], []byte{0})
+				m.labels[binary.LittleEndian.Uint32(b[8:])] = string(text)
+			}
+			b = b[min(8+ssz+ssz%2, len(b)):]
+		}
+	}
+	return nil
+}
+
+// readTrailing reads the metadata chunks following the data chunk of size sz,
+// which starts at the current offset of s, and then seeks back to it. r reads
+// from s.
+func (m *metadata) readTrailing(s io.Seeker, r io.Reader, sz uint32) error {
+	start, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Seek(int64(sz)+int64(sz%2), io.SeekCurrent); err != nil {
+		return err
+	}
+
+	header := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			// a truncated trailing chunk header ends the file
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return err
+		}
+		typ := string(header[:4])
+		if err := m.readChunk(r, typ, binary.LittleEndian.Uint32(header[4:])); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return err
+		}
+	}
+
+	_, err = s.Seek(start, io.SeekStart)
+	return err
+}
+
+// markers returns the cue points with their labels.
+func (m *metadata) markers() []Marker {
+	if len(m.cues) == 0 {
+		return nil
+	}
+	r := make([]Marker, len(m.cues))
+	for i, c := range m.cues {
+		r[i] = Marker{Position: c.position, Label: m.labels[c.id]}
+	}
+	return r
+}
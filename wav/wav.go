@@ -16,8 +16,10 @@
 
 // Package wav provides support for the WAV file format.
 //
-// Supported formats are PCM 8- and 16-bit, and IEEE float. Extended chunks
-// (JUNK, bext, and others added by tools like ProTools) are ignored.
+// Supported formats are PCM 8- and 16-bit, and IEEE float. Cue points and
+// their labels are read as Markers, and sampler loops and root notes ("smpl"
+// chunk) as a Sampler. Other extended chunks (JUNK, bext, and others added by
+// tools like ProTools) are ignored.
 package wav
 
 import (
@@ -27,6 +29,8 @@ import (
 	"io"
 	"math"
 	"time"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
 )
 
 const (
@@ -51,11 +55,58 @@ type Wav struct {
 	Samples int
 	// Duration is the estimated duration based on reported samples.
 	Duration time.Duration
+	// Markers are the cue points of the file, in the order they are listed.
+	Markers []Marker
+	// Sampler is the sampler metadata of the file, or nil if it has none.
+	Sampler *Sampler
 
 	r io.Reader
 }
 
+// Marker is a cue point, from the "cue " chunk, with its label from the
+// associated data list ("LIST" chunk of type "adtl"), if any.
+type Marker struct {
+	// Position is the offset of the marker in sample frames (samples per
+	// channel) from the start of the data.
+	Position uint32
+	Label    string
+}
+
+// Sampler is the metadata of a sampled instrument, from the "smpl" chunk.
+type Sampler struct {
+	// RootNote is the MIDI note at which the sample plays at its original
+	// pitch (60 is middle C).
+	RootNote uint32
+	// PitchFraction is the fraction of a semitone above RootNote of the
+	// original pitch, in units of 1/2^32 semitone.
+	PitchFraction uint32
+	Loops         []Loop
+}
+
+// Loop is a sampler loop.
+type Loop struct {
+	Type LoopType
+	// Start and End are the offsets of the first and last sample frames of
+	// the loop from the start of the data; End is inclusive.
+	Start, End uint32
+	// PlayCount is the number of times to play the loop, or 0 to loop until
+	// the note is released (a sustain loop).
+	PlayCount uint32
+}
+
+// LoopType is the playback direction of a Loop.
+type LoopType uint32
+
+const (
+	LoopForward     LoopType = iota // play start to end
+	LoopAlternating                 // play forward, then backward
+	LoopBackward                    // play end to start
+)
+
 // New reads the WAV header from r.
+// Metadata chunks before the data chunk are parsed. Those after it, where
+// cue points are usually stored, are parsed only if r is an io.Seeker; r is
+// then left at the start of the sample data.
 func New(r io.Reader) (*Wav, error) {
 	var w Wav
 	header := make([]byte, 16)
@@ -69,6 +120,7 @@ func New(r io.Reader) (*Wav, error) {
 		return nil, fmt.Errorf("wav: missing WAVE")
 	}
 	hasFmt := false
+	var m metadata
 	for {
 		if _, err := io.ReadFull(r, header[:8]); err != nil {
 			return nil, err
@@ -90,20 +142,31 @@ func New(r io.Reader) (*Wav, error) {
 			case wavFormatPCM:
 			case wavFormatIEEEFloat:
 			default:
-				return nil, fmt.Errorf("wav: unknown audio format: %02x", w.AudioFormat)
+				return nil, fmt.Errorf("wav: unknown audio format: %02x: %w", w.AudioFormat, dsputils.ErrUnsupportedFormat)
 			}
 			hasFmt = true
+			if sz%2 == 1 {
+				if _, err := io.CopyN(io.Discard, r, 1); err != nil {
+					return nil, err
+				}
+			}
 		case "data":
 			if !hasFmt {
 				return nil, fmt.Errorf("wav: unexpected fmt chunk")
 			}
 			w.Samples = int(sz) / int(w.BitsPerSample) * 8
 			w.Duration = time.Duration(w.Samples) * time.Second / time.Duration(w.SampleRate) / time.Duration(w.NumChannels)
+			if s, ok := r.(io.Seeker); ok {
+				if err := m.readTrailing(s, r, sz); err != nil {
+					return nil, err
+				}
+			}
+			w.Markers = m.markers()
+			w.Sampler = m.sampler
 			w.r = io.LimitReader(r, int64(sz))
 			return &w, nil
 		default:
-			_, err := io.CopyN(io.Discard, r, int64(sz))
-			if err != nil {
+			if err := m.readChunk(r, typ, sz); err != nil {
 				return nil, err
 			}
 		}
@@ -122,12 +185,12 @@ func (w *Wav) ReadSamples(n int) (interface{}, error) {
 		case 16:
 			data = make([]int16, n)
 		default:
-			return nil, fmt.Errorf("wav: unknown bits per sample: %v", w.BitsPerSample)
+			return nil, fmt.Errorf("wav: unknown bits per sample: %v: %w", w.BitsPerSample, dsputils.ErrUnsupportedFormat)
 		}
 	case wavFormatIEEEFloat:
 		data = make([]float32, n)
 	default:
-		return nil, fmt.Errorf("wav: unknown audio format")
+		return nil, fmt.Errorf("wav: unknown audio format: %w", dsputils.ErrUnsupportedFormat)
 	}
 	if err := binary.Read(w.r, binary.LittleEndian, data); err != nil {
 		return nil, err
@@ -157,7 +220,7 @@ func (w *Wav) ReadFloats(n int) ([]float32, error) {
 	case []float32:
 		f = d
 	default:
-		return nil, fmt.Errorf("wav: unknown type: %T", d)
+		return nil, fmt.Errorf("wav: unknown type: %T: %w", d, dsputils.ErrUnsupportedFormat)
 	}
 	return f, nil
 }
@@ -2,9 +2,15 @@ package wav
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
 	"os"
+	"path/filepath"
 	"reflect"
+	"runtime"
 	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
 )
 
 func checkHeader(b []byte) error {
@@ -109,5 +115,213 @@ func TestWav(t *testing.T) {
 
 func eq(x, y Wav) bool {
 	x.r, y.r = nil, nil
-	return x == y
+	return reflect.DeepEqual(x, y)
+}
+
+func TestMarkers(t *testing.T) {
+	w := &Wav{
+		Header: Header{
+			AudioFormat:   wavFormatPCM,
+			NumChannels:   2,
+			SampleRate:    8000,
+			BitsPerSample: 16,
+		},
+		Markers: []Marker{
+			{Position: 10, Label: "verse"},
+			{Position: 75, Label: "chorus!"},
+		},
+	}
+	data := make([]int16, 200)
+	for i := range data {
+		data[i] = int16(i * 100)
+	}
+
+	var b bytes.Buffer
+	if err := Write(&b, w, data); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := New(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r.Markers, w.Markers) {
+		t.Errorf("markers not equal\ngot: %v\nexpected: %v", r.Markers, w.Markers)
+	}
+	if r.Samples != len(data) || r.BlockAlign != 4 || r.ByteRate != 32000 {
+		t.Errorf("bad header: %+v, %v samples", r.Header, r.Samples)
+	}
+	s, err := r.ReadSamples(len(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s, data) {
+		t.Errorf("samples not equal\ngot: %v\nexpected: %v", s, data)
+	}
+
+	// markers after the data are skipped without a seeker
+	r, err = New(bytes.NewBuffer(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Markers != nil {
+		t.Errorf("unexpected markers: %v", r.Markers)
+	}
+}
+
+func TestSampler(t *testing.T) {
+	w := &Wav{
+		Header: Header{
+			AudioFormat:   wavFormatIEEEFloat,
+			NumChannels:   1,
+			SampleRate:    44100,
+			BitsPerSample: 32,
+		},
+		Sampler: &Sampler{
+			RootNote: 69,
+			Loops:    []Loop{{Type: LoopForward, Start: 100, End: 899}},
+		},
+	}
+	data := make([]float32, 1000)
+
+	var b bytes.Buffer
+	if err := Write(&b, w, data); err != nil {
+		t.Fatal(err)
+	}
+	r, err := New(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r.Sampler, w.Sampler) {
+		t.Errorf("sampler not equal\ngot: %+v\nexpected: %+v", r.Sampler, w.Sampler)
+	}
+	if r.Markers != nil {
+		t.Errorf("unexpected markers: %v", r.Markers)
+	}
+
+	// files without a smpl chunk have no sampler
+	w.Sampler = nil
+	b.Reset()
+	if err := Write(&b, w, data); err != nil {
+		t.Fatal(err)
+	}
+	if r, err = New(bytes.NewReader(b.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	if r.Sampler != nil {
+		t.Errorf("unexpected sampler: %+v", r.Sampler)
+	}
+}
+
+func TestTruncatedChunk(t *testing.T) {
+	w := &Wav{
+		Header: Header{
+			AudioFormat:   wavFormatPCM,
+			NumChannels:   1,
+			SampleRate:    8000,
+			BitsPerSample: 16,
+		},
+		Markers: []Marker{{Position: 3, Label: "a"}},
+	}
+	var b bytes.Buffer
+	if err := Write(&b, w, make([]int16, 10)); err != nil {
+		t.Fatal(err)
+	}
+
+	// a trailing cue chunk that claims nearly 4 GiB but holds 8 bytes
+	chunk := []byte("cue \xf0\xff\xff\xff")
+	chunk = append(chunk, make([]byte, 8)...)
+	data := append(b.Bytes(), chunk...)
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	r, err := New(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(r.Markers, w.Markers) {
+		t.Errorf("markers not equal\ngot: %v\nexpected: %v", r.Markers, w.Markers)
+	}
+	if n := after.TotalAlloc - before.TotalAlloc; n > 1<<20 {
+		t.Errorf("truncated chunk allocated %v bytes", n)
+	}
+}
+
+func TestOpenFile(t *testing.T) {
+	w := &Wav{
+		Header: Header{
+			AudioFormat:   wavFormatPCM,
+			NumChannels:   2,
+			SampleRate:    8000,
+			BitsPerSample: 16,
+		},
+	}
+	data := make([]int16, 2000)
+	for i := range data {
+		data[i] = int16(i * 10)
+	}
+
+	var b bytes.Buffer
+	if err := Write(&b, w, data); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "test.wav")
+	if err := os.WriteFile(path, b.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if r.Frames() != 1000 {
+		t.Errorf("bad frames: %v", r.Frames())
+	}
+
+	// the same values as reading the file from the start
+	s, err := New(bytes.NewReader(b.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := s.ReadFloats(len(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ch := range []int{0, 1} {
+		v, err := r.ReadRange(500, 10, ch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range v {
+			if e := float64(all[2*(500+i)+ch]); v[i] != e {
+				t.Errorf("ReadRange channel %d sample %d: got %v, expected %v", ch, 500+i, v[i], e)
+			}
+		}
+	}
+
+	for _, tt := range [][3]int{{995, 10, 0}, {-1, 1, 0}, {0, 1, 2}} {
+		if _, err := r.ReadRange(tt[0], tt[1], tt[2]); !errors.Is(err, dsputils.ErrInvalidArgument) {
+			t.Errorf("ReadRange%v: got %v, expected %v", tt, err, dsputils.ErrInvalidArgument)
+		}
+	}
+}
+
+func TestUnsupportedFormat(t *testing.T) {
+	// an ADPCM (format 2) fmt chunk
+	var b bytes.Buffer
+	b.WriteString("RIFF")
+	binary.Write(&b, binary.LittleEndian, uint32(28))
+	b.WriteString("WAVEfmt ")
+	binary.Write(&b, binary.LittleEndian, uint32(16))
+	binary.Write(&b, binary.LittleEndian, Header{AudioFormat: 2, NumChannels: 1, SampleRate: 8000, BitsPerSample: 4})
+	if _, err := New(&b); !errors.Is(err, dsputils.ErrUnsupportedFormat) {
+		t.Errorf("New: got %v, expected %v", err, dsputils.ErrUnsupportedFormat)
+	}
+
+	w := &Wav{Header: Header{AudioFormat: wavFormatPCM, NumChannels: 1, SampleRate: 8000, BitsPerSample: 16}}
+	if err := Write(&b, w, []int32{1}); !errors.Is(err, dsputils.ErrUnsupportedFormat) {
+		t.Errorf("Write: got %v, expected %v", err, dsputils.ErrUnsupportedFormat)
+	}
 }
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+// Write writes a WAV file to out with the format of w.Header and the sample
+// data data, which is a []uint8, []int16, or []float32 as returned by
+// ReadSamples, with channels interleaved. The ByteRate and BlockAlign of the
+// header are computed from the other fields. The metadata of w (Markers and
+// Sampler) is written after the data; Samples and Duration are ignored.
+func Write(out io.Writer, w *Wav, data interface{}) error {
+	h := w.Header
+	switch d := data.(type) {
+	case []uint8:
+		if h.AudioFormat != wavFormatPCM || h.BitsPerSample != 8 {
+			return fmt.Errorf("wav: %T does not match format", d)
+		}
+	case []int16:
+		if h.AudioFormat != wavFormatPCM || h.BitsPerSample != 16 {
+			return fmt.Errorf("wav: %T does not match format", d)
+		}
+	case []float32:
+		if h.AudioFormat != wavFormatIEEEFloat || h.BitsPerSample != 32 {
+			return fmt.Errorf("wav: %T does not match format", d)
+		}
+	default:
+		return fmt.Errorf("wav: unknown type: %T: %w", d, dsputils.ErrUnsupportedFormat)
+	}
+	h.BlockAlign = h.NumChannels * h.BitsPerSample / 8
+	h.ByteRate = h.SampleRate * uint32(h.BlockAlign)
+
+	var body bytes.Buffer
+	body.WriteString("WAVE")
+	writeChunk(&body, "fmt ", h)
+	writeChunk(&body, "data", data)
+	w.writeMetadata(&body)
+
+	var riff bytes.Buffer
+	riff.WriteString("RIFF")
+	binary.Write(&riff, binary.LittleEndian, uint32(body.Len()))
+	if _, err := out.Write(riff.Bytes()); err != nil {
+		return err
+	}
+	_, err := body.WriteTo(out)
+	return err
+}
+
+// writeMetadata writes the metadata chunks of w to b.
+func (w *Wav) writeMetadata(b *bytes.Buffer) {
+	if s := w.Sampler; s != nil {
+		var period uint32
+		if w.SampleRate > 0 {
+			period = 1e9 / w.SampleRate
+		}
+		smpl := []uint32{0, 0, period, s.RootNote, s.PitchFraction, 0, 0, uint32(len(s.Loops)), 0}
+		for i, l := range s.Loops {
+			smpl = append(smpl, uint32(i), uint32(l.Type), l.Start, l.End, 0, l.PlayCount)
+		}
+		writeChunk(b, "smpl", smpl)
+	}
+	if len(w.Markers) == 0 {
+		return
+	}
+
+	var cue, list bytes.Buffer
+	binary.Write(&cue, binary.LittleEndian, uint32(len(w.Markers)))
+	list.WriteString("adtl")
+	for i, m := range w.Markers {
+		id := uint32(i + 1)
+		binary.Write(&cue, binary.LittleEndian, []uint32{id, m.Position})
+		cue.WriteString("data")
+		binary.Write(&cue, binary.LittleEndian, []uint32{0, 0, m.Position})
+
+		label := append(binary.LittleEndian.AppendUint32(nil, id), m.Label...)
+		writeChunk(&list, "labl", append(label, 0))
+	}
+	writeChunk(b, "cue ", cue.Bytes())
+	writeChunk(b, "LIST", list.Bytes())
+}
+
+// writeChunk writes the chunk typ with the little-endian encoding of data as
+// its body, padded to an even length, to b.
+func writeChunk(b *bytes.Buffer, typ string, data interface{}) {
+	var body bytes.Buffer
+	binary.Write(&body, binary.LittleEndian, data)
+
+	n := body.Len()
+	b.WriteString(typ)
+	binary.Write(b, binary.LittleEndian, uint32(n))
+	body.WriteTo(b)
+	if n%2 == 1 {
+		b.WriteByte(0)
+	}
+}
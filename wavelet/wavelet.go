@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+// Package wavelet provides wavelet transforms for digital signal processing.
+package wavelet
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+// MotherWavelet is a mother wavelet defined by its Fourier transform.
+type MotherWavelet interface {
+	// Transform returns the Fourier transform of the unit-scale wavelet at
+	// angular frequency w (in radians per sample).
+	Transform(w float64) complex128
+
+	// FourierPeriod returns the equivalent Fourier period of scale s.
+	FourierPeriod(s float64) float64
+
+	// EFolding returns the e-folding time of the wavelet power at scale s.
+	// Coefficients closer than this to either edge of the signal are within
+	// the cone of influence and are affected by edge effects.
+	EFolding(s float64) float64
+}
+
+// Morlet is the complex Morlet wavelet.
+// Reference: Torrence and Compo, "A Practical Guide to Wavelet Analysis," 1998.
+type Morlet struct {
+	// Omega0 is the nondimensional center frequency of the wavelet.
+	//
+	// The default value is 0, which uses 6.
+	Omega0 float64
+}
+
+func (m Morlet) omega0() float64 {
+	if m.Omega0 == 0 {
+		return 6
+	}
+	return m.Omega0
+}
+
+// Transform returns the Fourier transform of the Morlet wavelet at w.
+func (m Morlet) Transform(w float64) complex128 {
+	if w <= 0 {
+		return 0
+	}
+
+	d := w - m.omega0()
+	return complex(math.Pow(math.Pi, -0.25)*math.Exp(-d*d/2), 0)
+}
+
+// FourierPeriod returns the equivalent Fourier period of scale s.
+func (m Morlet) FourierPeriod(s float64) float64 {
+	w0 := m.omega0()
+	return 4 * math.Pi * s / (w0 + math.Sqrt(2+w0*w0))
+}
+
+// EFolding returns the e-folding time of the wavelet power at scale s.
+func (m Morlet) EFolding(s float64) float64 {
+	return math.Sqrt2 * s
+}
+
+// CWT returns the continuous wavelet transform of x at the given scales (in
+// samples), using the mother wavelet wave. The returned matrix has one row
+// per scale, each of length len(x).
+// The transform is computed in the frequency domain: x is zero-padded to the
+// next power of 2, and each row is the inverse FFT of the product of the
+// signal's spectrum and the scaled wavelet's spectrum.
+func CWT(x []float64, scales []float64, wave MotherWavelet) [][]complex128 {
+	lx := len(x)
+	r := make([][]complex128, len(scales))
+	if lx == 0 {
+		for i := range r {
+			r[i] = []complex128{}
+		}
+		return r
+	}
+
+	n := dsputils.NextPowerOf2(lx)
+	xf := fft.FFT(dsputils.ZeroPad(dsputils.ToComplex(x), n))
+
+	// angular frequencies of each FFT bin
+	w := make([]float64, n)
+	for k := range w {
+		if k <= n/2 {
+			w[k] = 2 * math.Pi * float64(k) / float64(n)
+		} else {
+			w[k] = -2 * math.Pi * float64(n-k) / float64(n)
+		}
+	}
+
+	t := make([]complex128, n)
+	for i, s := range scales {
+		norm := complex(math.Sqrt(2*math.Pi*s), 0)
+		for k, v := range xf {
+			t[k] = v * cmplx.Conj(wave.Transform(s*w[k])) * norm
+		}
+
+		r[i] = fft.IFFT(t)[:lx]
+	}
+
+	return r
+}
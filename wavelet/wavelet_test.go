@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package wavelet
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestCWTBurst(t *testing.T) {
+	const (
+		n      = 1024
+		center = 600
+		period = 32.0
+	)
+
+	// A Gaussian-windowed tone burst localized around center.
+	x := make([]float64, n)
+	for i := range x {
+		d := float64(i-center) / 40
+		x[i] = math.Exp(-d*d) * math.Cos(2*math.Pi*float64(i)/period)
+	}
+
+	wave := Morlet{}
+	var scales []float64
+	for s := 2.0; s <= 128; s *= math.Pow(2, 0.125) {
+		scales = append(scales, s)
+	}
+
+	w := CWT(x, scales, wave)
+	if len(w) != len(scales) {
+		t.Fatal("CWT error\nrows:", len(w), "\nexpected:", len(scales))
+	}
+
+	var peak float64
+	var ps, pt int
+	for i, row := range w {
+		if len(row) != n {
+			t.Fatal("CWT error\ncolumns:", len(row), "\nexpected:", n)
+		}
+		for j, v := range row {
+			if a := cmplx.Abs(v); a > peak {
+				peak, ps, pt = a, i, j
+			}
+		}
+	}
+
+	if math.Abs(float64(pt-center)) > 2 {
+		t.Error("CWT peak time error\noutput:", pt, "\nexpected:", center)
+	}
+	if p := wave.FourierPeriod(scales[ps]); math.Abs(p-period)/period > 0.1 {
+		t.Error("CWT peak period error\noutput:", p, "\nexpected:", period)
+	}
+
+	// Outside the cone of influence of the burst the response must be small.
+	coi := 3 * wave.EFolding(scales[ps])
+	for j, v := range w[ps] {
+		if math.Abs(float64(j-center)) > 3*40+coi && cmplx.Abs(v) > peak*1e-3 {
+			t.Error("CWT leakage error\ntime:", j, "\noutput:", cmplx.Abs(v), "\npeak:", peak)
+			break
+		}
+	}
+}
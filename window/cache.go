@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package window
+
+import (
+	"sync"
+)
+
+type cacheKey struct {
+	kind string
+	L    int
+}
+
+var (
+	cacheLock sync.RWMutex
+	cache     = map[cacheKey][]float64{}
+)
+
+// cached returns a copy of the L-point window of the given kind, computing it
+// with gen and caching it on first use. Callers own the returned slice, so
+// windowing in place cannot corrupt the cache.
+func cached(kind string, L int, gen func(int) []float64) []float64 {
+	k := cacheKey{kind, L}
+
+	cacheLock.RLock()
+	w := cache[k]
+	cacheLock.RUnlock()
+
+	if w == nil {
+		w = gen(L)
+
+		cacheLock.Lock()
+		cache[k] = w
+		cacheLock.Unlock()
+	}
+
+	r := make([]float64, len(w))
+	copy(r, w)
+	return r
+}
+
+// ClearCache frees the cached window values. The Hamming, Hann, Bartlett,
+// FlatTop and Blackman windows, and their symmetric and periodic variants,
+// are cached for each length they are called with, so that repeated calls
+// only copy the values; the cache grows with the number of distinct lengths
+// used.
+func ClearCache() {
+	cacheLock.Lock()
+	cache = map[cacheKey][]float64{}
+	cacheLock.Unlock()
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package window
+
+import (
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestCache(t *testing.T) {
+	ClearCache()
+
+	for _, w := range []struct {
+		name string
+		f    func(int) []float64
+		gen  func(int) []float64
+	}{
+		{"Hamming", Hamming, hamming},
+		{"Hann", Hann, hann},
+		{"Bartlett", Bartlett, bartlett},
+		{"FlatTop", FlatTop, flatTop},
+		{"Blackman", Blackman, blackman},
+	} {
+		a, b := w.f(64), w.f(64)
+		if !dsputils.PrettyClose(a, b) {
+			t.Error(w.name, "cache error\noutput:", b, "\nexpected:", a)
+		}
+		if &a[0] == &b[0] {
+			t.Error(w.name, "cache error: calls returned the same slice")
+		}
+
+		// mutating a result must not affect later calls
+		for i := range a {
+			a[i] = -1
+		}
+		if e, c := w.gen(64), w.f(64); !dsputils.PrettyClose(c, e) {
+			t.Error(w.name, "cache mutation error\noutput:", c, "\nexpected:", e)
+		}
+	}
+
+	ClearCache()
+	if len(cache) != 0 {
+		t.Error("ClearCache error\noutput:", len(cache), "\nexpected:", 0)
+	}
+}
+
+func BenchmarkHann(b *testing.B) {
+	b.Run("cached", func(b *testing.B) {
+		for b.Loop() {
+			Hann(1024)
+		}
+	})
+	b.Run("uncached", func(b *testing.B) {
+		for b.Loop() {
+			hann(1024)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package window
+
+// WindowKind identifies a window function for NewWindow.
+type WindowKind int
+
+const (
+	RectangularWindow WindowKind = iota
+	HammingWindow
+	HannWindow
+	BartlettWindow
+	FlatTopWindow
+	BlackmanWindow
+	// KaiserWindow takes one parameter, beta; see Kaiser.
+	KaiserWindow
+)
+
+// NewWindow returns the n-point periodic window of the given kind with the
+// parameters params, together with its figures of merit from Properties, so
+// that the correction factors applied to a spectrum always match the window
+// used. Periodic windows are the ones used for spectral analysis with the
+// FFT, for which the figures are tabulated: a Hann window has an ENBW of 1.5
+// bins and a coherent gain of 0.5. It panics if the number of params does not
+// match kind.
+func NewWindow(kind WindowKind, n int, params ...float64) (coeffs []float64, stats WindowStats) {
+	var f func(int) []float64
+	switch kind {
+	case RectangularWindow:
+		f = Rectangular
+	case HammingWindow:
+		f = Hamming
+	case HannWindow:
+		f = Hann
+	case BartlettWindow:
+		f = Bartlett
+	case FlatTopWindow:
+		f = FlatTop
+	case BlackmanWindow:
+		f = Blackman
+	case KaiserWindow:
+		if len(params) != 1 {
+			panic("kaiser window needs beta")
+		}
+		beta := params[0]
+		f = func(L int) []float64 { return Kaiser(L, beta) }
+		params = nil
+	default:
+		panic("unknown window kind")
+	}
+	if len(params) != 0 {
+		panic("window has no parameters")
+	}
+
+	coeffs = periodic(f, n)
+	return coeffs, Properties(coeffs)
+}
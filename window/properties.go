@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package window
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/madelynnblue/go-dsp/fft"
+)
+
+// WindowStats are figures of merit of a window for spectral analysis.
+// Reference: Harris, "On the Use of Windows for Harmonic Analysis with the
+// Discrete Fourier Transform," 1978.
+type WindowStats struct {
+	// ENBW is the equivalent noise bandwidth in bins: the width of the
+	// rectangular filter that passes the same white noise power.
+	ENBW float64
+
+	// CoherentGain is the mean of the window values, the gain applied to a
+	// sinusoid centered on a bin.
+	CoherentGain float64
+
+	// ScallopLoss is the loss in dB (a positive number) of a sinusoid halfway
+	// between two bins, relative to one centered on a bin.
+	ScallopLoss float64
+
+	// HighestSidelobe is the level in dB (a negative number) of the highest
+	// sidelobe of the window's spectrum relative to its main lobe.
+	HighestSidelobe float64
+}
+
+// Properties returns the figures of merit of the window win. The spectrum is
+// evaluated at 1/16 bin spacing, with a 16 times zero-padded FFT.
+func Properties(win []float64) WindowStats {
+	n := len(win)
+	if n == 0 {
+		panic("empty window")
+	}
+
+	var sum, sum2 float64
+	for _, w := range win {
+		sum += w
+		sum2 += w * w
+	}
+
+	const pad = 16
+	mag := spectrumMag(win, pad*n/2+1, pad*n)
+
+	// the main lobe ends at the first minimum
+	edge := 1
+	for edge < len(mag)-1 && mag[edge+1] < mag[edge] {
+		edge++
+	}
+	var side float64
+	for _, m := range mag[edge:] {
+		side = max(side, m)
+	}
+
+	return WindowStats{
+		ENBW:            float64(n) * sum2 / (sum * sum),
+		CoherentGain:    sum / float64(n),
+		ScallopLoss:     -20 * math.Log10(mag[pad/2]/mag[0]),
+		HighestSidelobe: 20 * math.Log10(side/mag[0]),
+	}
+}
+
+// spectrumMag returns the magnitude of the first bins bins of the
+// nfft-point FFT of x zero-padded to nfft points.
+func spectrumMag(x []float64, bins, nfft int) []float64 {
+	xp := make([]float64, nfft)
+	copy(xp, x)
+	X := fft.FFTReal(xp)
+
+	r := make([]float64, bins)
+	for k := range r {
+		r[k] = cmplx.Abs(X[k])
+	}
+	return r
+}
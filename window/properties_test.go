@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2026 Madelynn Blue <blue.mlynn@gmail.com>
+ *
+ * Permission to use, copy, modify, and distribute this software for any
+ * purpose with or without fee is hereby granted, provided that the above
+ * copyright notice and this permission notice appear in all copies.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ * WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ * MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ * ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ * WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ * ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ * OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package window
+
+import (
+	"math"
+	"testing"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
+)
+
+func TestProperties(t *testing.T) {
+	// Harris, table 1, for periodic ("DFT-even") windows
+	for _, tt := range []struct {
+		name string
+		win  []float64
+		e    WindowStats
+	}{
+		{"Rectangular", Rectangular(64), WindowStats{1, 1, 3.92, -13.3}},
+		{"Hann", HannPeriodic(64), WindowStats{1.5, 0.5, 1.42, -31.5}},
+	} {
+		o := Properties(tt.win)
+		if math.Abs(o.ENBW-tt.e.ENBW) > 0.01 ||
+			math.Abs(o.CoherentGain-tt.e.CoherentGain) > 1e-9 ||
+			math.Abs(o.ScallopLoss-tt.e.ScallopLoss) > 0.02 ||
+			math.Abs(o.HighestSidelobe-tt.e.HighestSidelobe) > 0.5 {
+			t.Error("Properties error\nwindow:", tt.name, "\noutput:", o, "\nexpected:", tt.e)
+		}
+	}
+}
+
+func TestNewWindow(t *testing.T) {
+	w, s := NewWindow(HannWindow, 64)
+	if !dsputils.PrettyClose(w, HannPeriodic(64)) {
+		t.Error("NewWindow error\noutput:", w, "\nexpected:", HannPeriodic(64))
+	}
+	if !dsputils.Float64Equal(s.ENBW, 1.5) || !dsputils.Float64Equal(s.CoherentGain, 0.5) {
+		t.Error("NewWindow stats error\noutput:", s, "\nexpected: ENBW 1.5, coherent gain 0.5")
+	}
+	if e := Properties(w); s != e {
+		t.Error("NewWindow stats error\noutput:", s, "\nexpected:", e)
+	}
+
+	// Kaiser with beta 0 is rectangular
+	w, s = NewWindow(KaiserWindow, 16, 0)
+	if !dsputils.PrettyClose(w, Rectangular(16)) || !dsputils.Float64Equal(s.ENBW, 1) {
+		t.Error("NewWindow Kaiser error\noutput:", w, s)
+	}
+
+	for _, tt := range []struct {
+		name string
+		f    func()
+	}{
+		{"missing beta", func() { NewWindow(KaiserWindow, 16) }},
+		{"extra parameter", func() { NewWindow(HannWindow, 16, 1) }},
+		{"unknown kind", func() { NewWindow(WindowKind(-1), 16) }},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error(tt.name, "error\nexpected panic")
+				}
+			}()
+			tt.f()
+		}()
+	}
+}
@@ -19,6 +19,8 @@ package window
 
 import (
 	"math"
+
+	"github.com/madelynnblue/go-dsp/dsputils"
 )
 
 // Apply applies the window windowFunction to x.
@@ -28,6 +30,24 @@ func Apply(x []float64, windowFunction func(int) []float64) {
 	}
 }
 
+// ApplyComplex applies the window windowFunction to the complex-valued x. The
+// real and imaginary parts are both scaled.
+func ApplyComplex(x []complex128, windowFunction func(int) []float64) {
+	ApplyComplexInto(x, x, windowFunction(len(x)))
+}
+
+// ApplyComplexInto stores src scaled by the window values win in dst, which
+// may be src. dst, src and win must have the same length.
+func ApplyComplexInto(dst, src []complex128, win []float64) {
+	if len(dst) != len(src) || len(src) != len(win) {
+		panic("arrays not of equal size")
+	}
+
+	for i, w := range win {
+		dst[i] = src[i] * complex(w, 0)
+	}
+}
+
 // Rectangular returns an L-point rectangular window (all values are 1).
 func Rectangular(L int) []float64 {
 	r := make([]float64, L)
@@ -39,9 +59,14 @@ func Rectangular(L int) []float64 {
 	return r
 }
 
-// Hamming returns an L-point symmetric Hamming window.
+// Hamming returns an L-point symmetric Hamming window. It is the same as
+// HammingSymmetric.
 // Reference: http://www.mathworks.com/help/signal/ref/hamming.html
 func Hamming(L int) []float64 {
+	return cached("hamming", L, hamming)
+}
+
+func hamming(L int) []float64 {
 	r := make([]float64, L)
 
 	if L == 1 {
@@ -57,9 +82,14 @@ func Hamming(L int) []float64 {
 	return r
 }
 
-// Hann returns an L-point Hann window.
+// Hann returns an L-point symmetric Hann window. It is the same as
+// HannSymmetric.
 // Reference: http://www.mathworks.com/help/signal/ref/hann.html
 func Hann(L int) []float64 {
+	return cached("hann", L, hann)
+}
+
+func hann(L int) []float64 {
 	r := make([]float64, L)
 
 	if L == 1 {
@@ -75,9 +105,14 @@ func Hann(L int) []float64 {
 	return r
 }
 
-// Bartlett returns an L-point Bartlett window.
+// Bartlett returns an L-point symmetric Bartlett window. It is the same as
+// BartlettSymmetric.
 // Reference: http://www.mathworks.com/help/signal/ref/bartlett.html
 func Bartlett(L int) []float64 {
+	return cached("bartlett", L, bartlett)
+}
+
+func bartlett(L int) []float64 {
 	r := make([]float64, L)
 
 	if L == 1 {
@@ -97,9 +132,14 @@ func Bartlett(L int) []float64 {
 	return r
 }
 
-// FlatTop returns an L-point flat top window.
+// FlatTop returns an L-point symmetric flat top window. It is the same as
+// FlatTopSymmetric.
 // Reference: http://www.mathworks.com/help/signal/ref/flattopwin.html
 func FlatTop(L int) []float64 {
+	return cached("flatTop", L, flatTop)
+}
+
+func flatTop(L int) []float64 {
 	const (
 		alpha0 = float64(0.21557895)
 		alpha1 = float64(0.41663158)
@@ -133,9 +173,14 @@ func FlatTop(L int) []float64 {
 	return r
 }
 
-// Blackman returns an L-point Blackman window
+// Blackman returns an L-point symmetric Blackman window. It is the same as
+// BlackmanSymmetric.
 // Reference: http://www.mathworks.com/help/signal/ref/blackman.html
 func Blackman(L int) []float64 {
+	return cached("blackman", L, blackman)
+}
+
+func blackman(L int) []float64 {
 	r := make([]float64, L)
 	if L == 1 {
 		r[0] = 1
@@ -150,3 +195,101 @@ func Blackman(L int) []float64 {
 	}
 	return r
 }
+
+// Kaiser returns an L-point symmetric Kaiser window with shape parameter beta.
+// Larger beta lowers the sidelobes and widens the main lobe; beta 0 is the
+// rectangular window. It is dsputils.Kaiser, which the resamplers use.
+func Kaiser(L int, beta float64) []float64 {
+	return dsputils.Kaiser(L, beta)
+}
+
+// Symmetric windows are symmetric about their center, with equal first and
+// last values; they are used for FIR filter design. Periodic windows are one
+// period of an (L+1)-point symmetric window with its last point removed, so
+// that repeating them tiles evenly; they are used for spectral analysis with
+// the FFT (SciPy's sym=False, MATLAB's "periodic").
+
+// HannSymmetric returns an L-point symmetric Hann window.
+func HannSymmetric(L int) []float64 {
+	return Hann(L)
+}
+
+// HannPeriodic returns an L-point periodic Hann window.
+func HannPeriodic(L int) []float64 {
+	return periodic(Hann, L)
+}
+
+// HammingSymmetric returns an L-point symmetric Hamming window.
+func HammingSymmetric(L int) []float64 {
+	return Hamming(L)
+}
+
+// HammingPeriodic returns an L-point periodic Hamming window.
+func HammingPeriodic(L int) []float64 {
+	return periodic(Hamming, L)
+}
+
+// BartlettSymmetric returns an L-point symmetric Bartlett window.
+func BartlettSymmetric(L int) []float64 {
+	return Bartlett(L)
+}
+
+// BartlettPeriodic returns an L-point periodic Bartlett window.
+func BartlettPeriodic(L int) []float64 {
+	return periodic(Bartlett, L)
+}
+
+// FlatTopSymmetric returns an L-point symmetric flat top window.
+func FlatTopSymmetric(L int) []float64 {
+	return FlatTop(L)
+}
+
+// FlatTopPeriodic returns an L-point periodic flat top window.
+func FlatTopPeriodic(L int) []float64 {
+	return periodic(FlatTop, L)
+}
+
+// BlackmanSymmetric returns an L-point symmetric Blackman window.
+func BlackmanSymmetric(L int) []float64 {
+	return Blackman(L)
+}
+
+// BlackmanPeriodic returns an L-point periodic Blackman window.
+func BlackmanPeriodic(L int) []float64 {
+	return periodic(Blackman, L)
+}
+
+// periodic returns the L-point periodic version of the symmetric window
+// function symmetric.
+func periodic(symmetric func(int) []float64, L int) []float64 {
+	if L == 1 {
+		return []float64{1}
+	}
+	return symmetric(L + 1)[:L]
+}
+
+// OverlapAddNorm returns the overlap-add normalization of the window win with
+// frames hop samples apart: the per-sample sum of copies of win shifted by 0,
+// hop, 2*hop, ..., for every frame that fits entirely within length samples.
+// Dividing an overlap-added signal by it undoes the windowing wherever it is
+// non-zero. It is constant away from the ends when win and hop satisfy the
+// constant overlap-add (COLA) condition, such as a periodic Hann window at 50%
+// overlap. For the weighted overlap-add of an inverse STFT that also applies
+// win on synthesis, pass the squared window.
+func OverlapAddNorm(win []float64, hop int, length int) []float64 {
+	if hop < 1 {
+		panic("invalid hop")
+	}
+	if length < 0 {
+		panic("invalid length")
+	}
+
+	r := make([]float64, length)
+	for start := 0; start+len(win) <= length; start += hop {
+		for i, w := range win {
+			r[start+i] += w
+		}
+	}
+
+	return r
+}
@@ -17,6 +17,7 @@
 package window
 
 import (
+	"math/cmplx"
 	"testing"
 
 	"github.com/madelynnblue/go-dsp/dsputils"
@@ -92,3 +93,96 @@ func TestWindowFunctions(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyComplex(t *testing.T) {
+	for _, v := range windowTests {
+		// a complex exponential has unit magnitude, so the windowed magnitude
+		// envelope is the window
+		x := make([]complex128, v.in)
+		for i := range x {
+			x[i] = cmplx.Exp(complex(0, 0.3*float64(i)))
+		}
+
+		dst := make([]complex128, v.in)
+		ApplyComplexInto(dst, x, v.hann)
+		ApplyComplex(x, Hann)
+
+		for _, o := range [][]complex128{x, dst} {
+			mag := make([]float64, len(o))
+			for i, c := range o {
+				mag[i] = cmplx.Abs(c)
+			}
+			if !dsputils.PrettyClose(mag, v.hann) {
+				t.Error("ApplyComplex error\ninput:", v.in, "\noutput:", mag, "\nexpected:", v.hann)
+			}
+		}
+	}
+}
+
+func TestPeriodic(t *testing.T) {
+	if o, e := HannPeriodic(4), []float64{0, 0.5, 1, 0.5}; !dsputils.PrettyClose(o, e) {
+		t.Error("HannPeriodic error\ninput:", 4, "\noutput:", o, "\nexpected:", e)
+	}
+
+	for _, w := range []struct {
+		name                string
+		symmetric, periodic func(int) []float64
+	}{
+		{"Hann", HannSymmetric, HannPeriodic},
+		{"Hamming", HammingSymmetric, HammingPeriodic},
+		{"Bartlett", BartlettSymmetric, BartlettPeriodic},
+		{"FlatTop", FlatTopSymmetric, FlatTopPeriodic},
+		{"Blackman", BlackmanSymmetric, BlackmanPeriodic},
+	} {
+		if o := w.periodic(1); !dsputils.PrettyClose(o, []float64{1}) {
+			t.Error(w.name, "periodic error\ninput:", 1, "\noutput:", o, "\nexpected:", []float64{1})
+		}
+		for _, n := range []int{2, 5, 8, 64} {
+			o := w.periodic(n)
+			if e := w.symmetric(n + 1)[:n]; !dsputils.PrettyClose(o, e) {
+				t.Error(w.name, "periodic error\ninput:", n, "\noutput:", o, "\nexpected:", e)
+			}
+		}
+	}
+}
+
+func TestOverlapAddNorm(t *testing.T) {
+	// a periodic Hann window at 50% overlap sums to 1 away from the ends
+	win, hop := HannPeriodic(16), 8
+	o := OverlapAddNorm(win, hop, 128)
+	for i := hop; i < len(o)-hop; i++ {
+		if !dsputils.Float64Equal(o[i], 1) {
+			t.Error("OverlapAddNorm COLA error\nindex:", i, "\noutput:", o[i], "\nexpected:", 1)
+			break
+		}
+	}
+	if e := win[:hop]; !dsputils.PrettyClose(o[:hop], e) {
+		t.Error("OverlapAddNorm edge error\noutput:", o[:hop], "\nexpected:", e)
+	}
+
+	// at 25% overlap it does not
+	o = OverlapAddNorm(win, 12, 128)
+	lo, hi := o[12], o[12]
+	for _, v := range o[12 : len(o)-16] {
+		lo, hi = min(lo, v), max(hi, v)
+	}
+	if hi-lo < 0.1 {
+		t.Error("OverlapAddNorm non-COLA error\nrange:", lo, hi)
+	}
+
+	// only whole frames are added
+	if o, e := OverlapAddNorm([]float64{1, 2, 3}, 2, 8), []float64{1, 2, 4, 2, 4, 2, 3, 0}; !dsputils.PrettyClose(o, e) {
+		t.Error("OverlapAddNorm error\noutput:", o, "\nexpected:", e)
+	}
+}
+
+func TestKaiser(t *testing.T) {
+	// I0(5*sqrt(1-x^2))/I0(5) for x from -1 to 1
+	e := []float64{0.03671089, 0.32820196, 0.7753221, 1, 0.7753221, 0.32820196, 0.03671089}
+	if o := Kaiser(7, 5); !dsputils.PrettyClose(o, e) {
+		t.Error("Kaiser error\ninput:", 7, 5, "\noutput:", o, "\nexpected:", e)
+	}
+	if o := Kaiser(1, 5); !dsputils.PrettyClose(o, []float64{1}) {
+		t.Error("Kaiser error\ninput:", 1, 5, "\noutput:", o, "\nexpected:", []float64{1})
+	}
+}